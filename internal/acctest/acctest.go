@@ -45,11 +45,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -116,6 +118,16 @@ var (
 	ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error) = protoV5ProviderFactoriesInit(context.Background(), ProviderName)
 )
 
+// ProtoV6ProviderFactoriesEcho is a static map containing only the echo provider instance.
+//
+// Use with ConfigWithEchoProvider to check the values of ephemeral resources,
+// which are never persisted in state.
+var (
+	ProtoV6ProviderFactoriesEcho map[string]func() (tfprotov6.ProviderServer, error) = map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+)
+
 // Provider is the "main" provider instance
 //
 // This Provider can be used in testing code for API calls without requiring
@@ -141,12 +153,14 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			offlineEnabledProvider(primary)
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -166,6 +180,8 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 			t.Fatal(err)
 		}
 
+		offlineEnabledProvider(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -188,6 +204,8 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 			t.Fatal(err)
 		}
 
+		offlineEnabledProvider(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -272,9 +290,15 @@ func ProtoV5FactoriesMultipleRegions(ctx context.Context, t *testing.T, n int) m
 //
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
+//
+// In offline mode, tests not marked with OfflineCapable are skipped.
 func PreCheck(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	if isOfflineEnabled() {
+		preCheckOffline(t)
+	}
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
@@ -284,6 +308,8 @@ func PreCheck(ctx context.Context, t *testing.T) {
 			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
 		}
 
+		offlineEnabledProvider(Provider)
+
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
 		// a provider configuration with a region. This defaults to us-west-2 for provider
 		// developer simplicity and has been in the codebase for a very long time.
@@ -878,6 +904,11 @@ func PreCheckThirdAccount(t *testing.T) {
 func PreCheckPartitionHasService(t *testing.T, serviceID string) {
 	t.Helper()
 
+	// The local AWS API stand-in is used for all services.
+	if isOfflineEnabled() {
+		return
+	}
+
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), Region()); ok {
 		if _, ok := partition.Services()[serviceID]; !ok {
 			t.Skipf("skipping tests; partition %s does not support %s service", partition.ID(), serviceID)
@@ -1837,6 +1868,18 @@ data "aws_service" "provider_test" {
 }
 `
 
+// ConfigWithEchoProvider returns a configuration that copies the specified ephemeral
+// resource value to the `data` attribute of the `echo.test` resource.
+func ConfigWithEchoProvider(ephemeralResource string) string {
+	return fmt.Sprintf(`
+provider "echo" {
+  data = %[1]s
+}
+
+resource "echo" "test" {}
+`, ephemeralResource)
+}
+
 // ConfigCompose can be called to concatenate multiple strings to build test configurations
 func ConfigCompose(config ...string) string {
 	var str strings.Builder
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	defaultOfflineAccountID = "000000000000"
+	offlineCredential       = "test"
+)
+
+// offlineCapableTests contains the names of tests known to work in offline mode.
+var offlineCapableTests sync.Map
+
+// isOfflineEnabled returns whether acceptance tests run against a local AWS API stand-in instead of AWS.
+func isOfflineEnabled() bool {
+	return os.Getenv(envvar.OfflineEndpoint) != ""
+}
+
+// OfflineCapable marks the test as known to work in offline mode.
+// In offline mode, PreCheck skips tests, and their subtests, that are not marked.
+// It should be called before PreCheck, usually at the start of the test function.
+func OfflineCapable(t *testing.T) {
+	t.Helper()
+
+	offlineCapableTests.Store(t.Name(), struct{}{})
+}
+
+func isOfflineCapable(t *testing.T) bool {
+	t.Helper()
+
+	name := t.Name()
+	for {
+		if _, ok := offlineCapableTests.Load(name); ok {
+			return true
+		}
+
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			return false
+		}
+		name = name[:i]
+	}
+}
+
+// preCheckOffline skips tests not known to work in offline mode and sets placeholder credentials if none are configured.
+func preCheckOffline(t *testing.T) {
+	t.Helper()
+
+	if !isOfflineCapable(t) {
+		t.Skipf("skipping test; %s set and test not known to work offline", envvar.OfflineEndpoint)
+	}
+
+	if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.ContainerCredentialsFullURI) == "" {
+		os.Setenv(envvar.AccessKeyId, offlineCredential)
+		os.Setenv(envvar.SecretAccessKey, offlineCredential)
+	}
+}
+
+// offlineEnabledProvider configures the provider to send all AWS API requests to the local AWS API stand-in if offline mode is enabled.
+func offlineEnabledProvider(provider *schema.Provider) {
+	if isOfflineEnabled() {
+		provider.ConfigureContextFunc = offlineProviderConfigureContextFunc(provider.ConfigureContextFunc)
+	}
+}
+
+// offlineProviderConfigureContextFunc returns a provider configuration function that overrides the endpoint of every service
+// and skips the validation that requires AWS, such as retrieving the account ID from STS.
+func offlineProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		endpoint := os.Getenv(envvar.OfflineEndpoint)
+		endpoints := make(map[string]interface{})
+		for _, v := range names.Endpoints() {
+			endpoints[v.ProviderPackage] = endpoint
+		}
+
+		for k, v := range map[string]interface{}{
+			"endpoints":                   []interface{}{endpoints},
+			"s3_use_path_style":           true,
+			"skip_credentials_validation": true,
+			"skip_metadata_api_check":     "true",
+			"skip_region_validation":      true,
+			"skip_requesting_account_id":  true,
+		} {
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "configuring provider for offline testing: setting %s: %s", k, err)
+			}
+		}
+
+		meta, ds := configureContextFunc(ctx, d)
+		diags = append(diags, ds...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		// The account ID isn't requested from STS.
+		if v, ok := meta.(*conns.AWSClient); ok && v.AccountID == "" {
+			v.AccountID = envvar.GetWithDefault(envvar.OfflineAccountID, defaultOfflineAccountID)
+		}
+
+		return meta, diags
+	}
+}
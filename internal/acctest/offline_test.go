@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestOfflineCapable(t *testing.T) {
+	t.Run("marked", func(t *testing.T) {
+		OfflineCapable(t)
+
+		if !isOfflineCapable(t) {
+			t.Error("isOfflineCapable() = false, want true")
+		}
+
+		t.Run("subtest", func(t *testing.T) {
+			if !isOfflineCapable(t) {
+				t.Error("isOfflineCapable() = false, want true")
+			}
+		})
+	})
+
+	t.Run("unmarked", func(t *testing.T) {
+		if isOfflineCapable(t) {
+			t.Error("isOfflineCapable() = true, want false")
+		}
+	})
+}
+
+func TestOfflineProviderConfigureContextFunc(t *testing.T) {
+	ctx := context.Background()
+	endpoint := "http://localhost:4566"
+
+	t.Setenv(envvar.OfflineEndpoint, endpoint)
+	t.Setenv(envvar.OfflineAccountID, "")
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var d *schema.ResourceData
+	f := offlineProviderConfigureContextFunc(func(_ context.Context, v *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		d = v
+		return new(conns.AWSClient), nil
+	})
+
+	meta, diags := f(ctx, schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := meta.(*conns.AWSClient).AccountID, defaultOfflineAccountID; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+
+	for _, k := range []string{"s3_use_path_style", "skip_credentials_validation", "skip_region_validation", "skip_requesting_account_id"} {
+		if !d.Get(k).(bool) {
+			t.Errorf("%s = false, want true", k)
+		}
+	}
+
+	endpoints := d.Get("endpoints").(*schema.Set).List()
+	if got, want := len(endpoints), 1; got != want {
+		t.Fatalf("length of endpoints = %d, want %d", got, want)
+	}
+	for _, k := range []string{names.S3, names.STS, names.IAM} {
+		if got, want := endpoints[0].(map[string]interface{})[k], endpoint; got != want {
+			t.Errorf("endpoints.%s = %v, want %q", k, got, want)
+		}
+	}
+}
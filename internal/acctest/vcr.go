@@ -7,22 +7,18 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
+	"errors"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -35,8 +31,9 @@ import (
 )
 
 const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
+	envVarVCRCassettePerStep = "VCR_CASSETTE_PER_STEP"
+	envVarVCRMode            = "VCR_MODE"
+	envVarVCRPath            = "VCR_PATH"
 )
 
 type randomnessSource struct {
@@ -96,6 +93,10 @@ func isVCREnabled() bool {
 	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
 }
 
+func isVCRCassettePerStepEnabled() bool {
+	return os.Getenv(envVarVCRCassettePerStep) != ""
+}
+
 func vcrMode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case "RECORDING":
@@ -107,8 +108,70 @@ func vcrMode() (recorder.Mode, error) {
 	}
 }
 
+// vcrEnabledTestCase returns a copy of the TestCase whose provider factories, including those of its TestSteps, are ready for use with VCR.
+// If cassette-per-step is enabled, each TestStep records to and replays from its own cassette.
+func vcrEnabledTestCase(ctx context.Context, t *testing.T, c resource.TestCase) resource.TestCase {
+	t.Helper()
+
+	testName := t.Name()
+	steps := make([]resource.TestStep, len(c.Steps))
+
+	if !isVCRCassettePerStepEnabled() {
+		if c.ProtoV5ProviderFactories != nil {
+			c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories, testName)
+		}
+
+		for i, step := range c.Steps {
+			if step.ProtoV5ProviderFactories != nil {
+				step.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, step.ProtoV5ProviderFactories, testName)
+			}
+			steps[i] = step
+		}
+
+		c.Steps = steps
+
+		return c
+	}
+
+	// Providers must be specified at either the TestCase or TestStep level, so move all providers to the TestSteps.
+	for i, step := range c.Steps {
+		if step.ProviderFactories == nil {
+			step.ProviderFactories = c.ProviderFactories
+		}
+		if step.ProtoV5ProviderFactories == nil {
+			step.ProtoV5ProviderFactories = c.ProtoV5ProviderFactories
+		}
+		if step.ProtoV6ProviderFactories == nil {
+			step.ProtoV6ProviderFactories = c.ProtoV6ProviderFactories
+		}
+		if step.ExternalProviders == nil {
+			step.ExternalProviders = c.ExternalProviders
+		}
+		if step.ProtoV5ProviderFactories != nil {
+			step.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, step.ProtoV5ProviderFactories, vcrStepCassetteName(testName, i))
+		}
+		steps[i] = step
+	}
+
+	c.ExternalProviders = nil
+	c.ProviderFactories = nil
+	c.ProtoV5ProviderFactories = nil
+	c.ProtoV6ProviderFactories = nil
+	c.Steps = steps
+
+	return c
+}
+
+// vcrStepCassetteName returns the name of the cassette for the TestStep with the specified (0-based) index.
+func vcrStepCassetteName(testName string, i int) string {
+	return fmt.Sprintf("%s/step-%d", testName, i+1)
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
-func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
+// All provider instances created by the factories share the provider instance state of the named cassette.
+// The Plugin Framework provider muxed with the primary (Plugin SDK V2) provider uses the primary provider's instance state,
+// so AWS API calls made by Plugin Framework resources and data sources are also recorded and replayed.
+func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error), cassetteName string) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
 
 	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
@@ -121,7 +184,8 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			offlineEnabledProvider(primary)
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name(), cassetteName)
 
 			return providerServerFactory(), nil
 		}
@@ -133,15 +197,18 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
 // This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
-func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
+func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName, cassetteName string) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var diags diag.Diagnostics
 
 		providerMetas.Lock()
-		meta, ok := providerMetas[testName]
+		meta, ok := providerMetas[cassetteName]
 		defer providerMetas.Unlock()
 
 		if ok {
+			// The TestStep's provider instance state is returned by ProviderMeta.
+			providerMetas[testName] = meta
+
 			return meta, nil
 		}
 
@@ -151,6 +218,23 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
+		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(cassetteName))
+
+		if cassetteName != testName {
+			// A new TestStep has started, so the previous TestSteps succeeded and their cassettes can be saved.
+			if err := stopVCRRecorders(ctx, testName, cassetteName); err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			// When recording, only TestSteps without a cassette are recorded.
+			// The other TestSteps run against AWS so that the recorded TestSteps see the same resources, but their cassettes are not overwritten.
+			if vcrMode == recorder.ModeRecordOnce {
+				if _, err := os.Stat(path + ".yaml"); err == nil {
+					vcrMode = recorder.ModePassthrough
+				}
+			}
+		}
+
 		// Cribbed from aws-sdk-go-base.
 		httpClient := cleanhttp.DefaultPooledClient()
 		transport := httpClient.Transport.(*http.Transport)
@@ -162,8 +246,6 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 		tlsConfig.MinVersion = tls.VersionTLS12
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
-
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.NewWithOptions(&recorder.Options{
 			CassetteName:  path,
@@ -175,90 +257,18 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
+		// Scrub secrets and account identifiers before saving.
+		sanitizer := newVCRSanitizer()
+		r.AddHook(sanitizer.afterCaptureHook, recorder.AfterCaptureHook)
+		r.AddHook(sanitizer.beforeSaveHook, recorder.BeforeSaveHook)
 
 		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
+		r.SetMatcher(vcrRequestMatcher(ctx))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient.Transport = vcrTransport{r}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -273,17 +283,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
-
+		providerMetas[cassetteName] = meta
 		providerMetas[testName] = meta
 
 		return meta, diags
@@ -292,6 +292,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
+// If cassette-per-step is enabled, an existing seed is reused.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
 func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	t.Helper()
@@ -315,6 +316,12 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	switch vcrMode {
 	case recorder.ModeRecordOnce:
 		seed := rand.Int63()
+		// With a cassette per TestStep, TestSteps are re-recorded individually and must use the same random values as the saved TestSteps.
+		if isVCRCassettePerStepEnabled() {
+			if v, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName)); err == nil {
+				seed = v
+			}
+		}
 		s = &randomnessSource{
 			seed:   seed,
 			source: rand.NewSource(seed),
@@ -393,20 +400,22 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 
 	testName := t.Name()
 	providerMetas.Lock()
-	meta, ok := providerMetas[testName]
+	_, ok := providerMetas[testName]
 	defer providerMetas.Unlock()
 
 	if ok {
-		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
-				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
-					t.Error(err)
+		if t.Failed() {
+			for name := range providerMetas {
+				if isVCRCassetteOfTest(name, testName) {
+					delete(providerMetas, name)
 				}
 			}
+		} else {
+			t.Log("stopping VCR recorder")
+			if err := stopVCRRecorders(ctx, testName, ""); err != nil {
+				t.Error(err)
+			}
 		}
-
-		delete(providerMetas, testName)
 	}
 
 	// Save the randomness seed.
@@ -415,7 +424,8 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	defer randomnessSources.Unlock()
 
 	if ok {
-		if !t.Failed() {
+		// With a cassette per TestStep, the cassettes of successful TestSteps are saved even if the test fails.
+		if !t.Failed() || isVCRCassettePerStepEnabled() {
 			t.Log("persisting randomness seed")
 			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
 				t.Error(err)
@@ -426,12 +436,78 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
+// stopVCRRecorders stops the VCR recorders of the test's cassettes, other than the excepted cassette, saving recorded interactions.
+// The providerMetas lock must be held.
+func stopVCRRecorders(ctx context.Context, testName, except string) error {
+	var errs []error
+	stopped := make(map[*recorder.Recorder]bool)
+
+	for name, meta := range providerMetas {
+		if name == except || !isVCRCassetteOfTest(name, testName) {
+			continue
+		}
+
+		delete(providerMetas, name)
+
+		// A test's provider instance state is shared with its current TestStep.
+		if v, ok := meta.HTTPClient(ctx).Transport.(vcrTransport); ok && !stopped[v.Recorder] {
+			stopped[v.Recorder] = true
+
+			if err := v.Stop(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func isVCRCassetteOfTest(name, testName string) bool {
+	return name == testName || strings.HasPrefix(name, testName+"/step-")
+}
+
+// vcrTransport is an http.RoundTripper that records and replays AWS API interactions.
+type vcrTransport struct {
+	*recorder.Recorder
+}
+
+func (t vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	response, err := t.Recorder.RoundTrip(r)
+
+	// Don't retry requests if a recorded interaction isn't found.
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		err = vcrInteractionNotFoundError{err}
+	}
+
+	return response, err
+}
+
+// vcrInteractionNotFoundError is returned when a request has no recorded interaction.
+// The AWS SDKs don't retry it.
+type vcrInteractionNotFoundError struct {
+	error
+}
+
+func (e vcrInteractionNotFoundError) Unwrap() error {
+	return e.error
+}
+
+// RetryableError implements AWS SDK for Go v2's retry.RetryableError interface.
+func (vcrInteractionNotFoundError) RetryableError() bool {
+	return false
+}
+
+// Temporary implements the interface used by AWS SDK for Go v1 to determine whether a connection error is retryable.
+func (vcrInteractionNotFoundError) Temporary() bool {
+	return false
+}
+
 // ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrEnabledTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
@@ -443,7 +519,7 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrEnabledTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
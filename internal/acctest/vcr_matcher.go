@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	vcrNormalizedTimestamp = "TIMESTAMP"
+	vcrNormalizedToken     = "TOKEN"
+)
+
+var (
+	// Request headers that must match.
+	// Signature, date and SDK invocation headers differ between recording and replay and are ignored.
+	vcrMatchedHeaders = []string{
+		"X-Amz-Target",
+	}
+
+	// Query parameters that are ignored when matching.
+	vcrIgnoredQueryParameters = []string{
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+	}
+
+	// Request fields holding idempotency tokens, which are generated randomly by the AWS SDKs.
+	vcrIdempotencyTokenFields = []string{
+		"CallerReference",
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+	}
+
+	vcrTimestampRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})$`)
+)
+
+// vcrRequestMatcher returns a matcher that matches requests to recorded interactions.
+// Method, URL (with query parameters compared unordered), selected headers and body must match.
+// JSON, XML and form-encoded bodies are normalized so that ordering, timestamps and idempotency tokens are ignored.
+func vcrRequestMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !vcrURLsMatch(r.URL, i.URL) {
+			return false
+		}
+
+		for _, k := range vcrMatchedHeaders {
+			if r.Header.Get(k) != i.Headers.Get(k) {
+				return false
+			}
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return i.Body == ""
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		match, err := vcrBodiesMatch(r.Header.Get("Content-Type"), body, i.Body)
+
+		if err != nil {
+			tflog.Debug(ctx, "Failed to compare request bodies", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		return match
+	}
+}
+
+func vcrURLsMatch(u *url.URL, recorded string) bool {
+	v, err := url.Parse(recorded)
+
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != v.Scheme || u.Host != v.Host || u.EscapedPath() != v.EscapedPath() {
+		return false
+	}
+
+	query, recordedQuery := u.Query(), v.Query()
+	for _, k := range vcrIgnoredQueryParameters {
+		query.Del(k)
+		recordedQuery.Del(k)
+	}
+
+	return reflect.DeepEqual(query, recordedQuery)
+}
+
+// vcrBodiesMatch compares request bodies of the specified content type.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrBodiesMatch(contentType, body, recorded string) (bool, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return false, err
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var v, recordedV interface{}
+
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			return false, err
+		}
+
+		if err := json.Unmarshal([]byte(recorded), &recordedV); err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(vcrNormalizeJSON(v), vcrNormalizeJSON(recordedV)), nil
+
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+
+		if err != nil {
+			return false, err
+		}
+
+		recordedValues, err := url.ParseQuery(recorded)
+
+		if err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(vcrNormalizeForm(values), vcrNormalizeForm(recordedValues)), nil
+
+	case "application/xml", "text/xml":
+		tokens, err := vcrNormalizeXML(body)
+
+		if err != nil {
+			return false, err
+		}
+
+		recordedTokens, err := vcrNormalizeXML(recorded)
+
+		if err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(tokens, recordedTokens), nil
+	}
+
+	return false, nil
+}
+
+func vcrNormalizeValue(k, v string) string {
+	if slices.Contains(vcrIdempotencyTokenFields, k) {
+		return vcrNormalizedToken
+	}
+
+	if vcrTimestampRegexp.MatchString(v) {
+		return vcrNormalizedTimestamp
+	}
+
+	return v
+}
+
+func vcrNormalizeJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if s, ok := e.(string); ok {
+				v[k] = vcrNormalizeValue(k, s)
+			} else {
+				v[k] = vcrNormalizeJSON(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			if s, ok := e.(string); ok {
+				v[i] = vcrNormalizeValue("", s)
+			} else {
+				v[i] = vcrNormalizeJSON(e)
+			}
+		}
+	}
+
+	return v
+}
+
+func vcrNormalizeForm(values url.Values) url.Values {
+	for k, vs := range values {
+		// Query protocol keys are paths such as "Tags.member.1.Key".
+		name := k
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			name = k[i+1:]
+		}
+
+		for i, v := range vs {
+			vs[i] = vcrNormalizeValue(name, v)
+		}
+	}
+
+	return values
+}
+
+// vcrNormalizeXML returns the XML document's tokens with insignificant whitespace removed.
+func vcrNormalizeXML(s string) ([]xml.Token, error) {
+	var tokens []xml.Token
+	var elements []string
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			elements = append(elements, t.Name.Local)
+			attrs := slices.Clone(t.Attr)
+			slices.SortFunc(attrs, func(a, b xml.Attr) int {
+				return strings.Compare(a.Name.Space+":"+a.Name.Local, b.Name.Space+":"+b.Name.Local)
+			})
+			tokens = append(tokens, xml.StartElement{Name: t.Name, Attr: attrs})
+		case xml.EndElement:
+			if n := len(elements); n > 0 {
+				elements = elements[:n-1]
+			}
+			tokens = append(tokens, t)
+		case xml.CharData:
+			v := strings.TrimSpace(string(t))
+			if v == "" {
+				continue
+			}
+			var name string
+			if n := len(elements); n > 0 {
+				name = elements[n-1]
+			}
+			tokens = append(tokens, xml.CharData(vcrNormalizeValue(name, v)))
+		case xml.Comment, xml.ProcInst, xml.Directive:
+			continue
+		}
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestVCRRequestMatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method      string
+		url         string
+		headers     http.Header
+		body        string
+		recorded    cassette.Request
+		expectMatch bool
+	}{
+		"identical": {
+			method:      http.MethodGet,
+			url:         "https://s3.amazonaws.com/bucket?list-type=2&prefix=a",                                                //lintignore:AWSAT003
+			recorded:    cassette.Request{Method: http.MethodGet, URL: "https://s3.amazonaws.com/bucket?list-type=2&prefix=a"}, //lintignore:AWSAT003
+			expectMatch: true,
+		},
+		"different method": {
+			method:   http.MethodPut,
+			url:      "https://s3.amazonaws.com/bucket",                                                //lintignore:AWSAT003
+			recorded: cassette.Request{Method: http.MethodGet, URL: "https://s3.amazonaws.com/bucket"}, //lintignore:AWSAT003
+		},
+		"reordered query ignoring signature": {
+			method:      http.MethodGet,
+			url:         "https://s3.amazonaws.com/bucket/key?X-Amz-Date=20240102T000000Z&b=2&a=1&X-Amz-Signature=def",                                                     //lintignore:AWSAT003
+			recorded:    cassette.Request{Method: http.MethodGet, URL: "https://s3.amazonaws.com/bucket/key?a=1&b=2&X-Amz-Date=20240101T000000Z&X-Amz-Signature=REDACTED"}, //lintignore:AWSAT003
+			expectMatch: true,
+		},
+		"different query": {
+			method:   http.MethodGet,
+			url:      "https://s3.amazonaws.com/bucket?prefix=a",                                                //lintignore:AWSAT003
+			recorded: cassette.Request{Method: http.MethodGet, URL: "https://s3.amazonaws.com/bucket?prefix=b"}, //lintignore:AWSAT003
+		},
+		"JSON reordered with token and timestamp": {
+			method: http.MethodPost,
+			url:    "https://logs.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			headers: http.Header{
+				"Content-Type": []string{"application/x-amz-json-1.1"},
+				"X-Amz-Date":   []string{"20240102T000000Z"},
+				"X-Amz-Target": []string{"Logs_20140328.CreateLogGroup"},
+			},
+			body: `{"logGroupName":"test","ClientToken":"a1","tags":{"k":"v"},"startTime":"2024-01-02T00:00:00Z"}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+				Headers: http.Header{
+					"X-Amz-Date":   []string{"20240101T000000Z"},
+					"X-Amz-Target": []string{"Logs_20140328.CreateLogGroup"},
+				},
+				Body: `{"tags":{"k":"v"},"startTime":"2024-01-01T00:00:00.123Z","ClientToken":"b2","logGroupName":"test"}`,
+			},
+			expectMatch: true,
+		},
+		"JSON different target": {
+			method: http.MethodPost,
+			url:    "https://logs.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			headers: http.Header{
+				"Content-Type": []string{"application/x-amz-json-1.1"},
+				"X-Amz-Target": []string{"Logs_20140328.DeleteLogGroup"},
+			},
+			body: `{"logGroupName":"test"}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+				Headers: http.Header{
+					"X-Amz-Target": []string{"Logs_20140328.CreateLogGroup"},
+				},
+				Body: `{"logGroupName":"test"}`,
+			},
+		},
+		"form reordered with token": {
+			method: http.MethodPost,
+			url:    "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			headers: http.Header{
+				"Content-Type": []string{"application/x-www-form-urlencoded; charset=utf-8"},
+			},
+			body: "Action=RunInstances&ClientToken=a1&ImageId=ami-1&Version=2016-11-15",
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+				Body:   "Version=2016-11-15&ImageId=ami-1&Action=RunInstances&ClientToken=b2",
+			},
+			expectMatch: true,
+		},
+		"form different value": {
+			method: http.MethodPost,
+			url:    "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			headers: http.Header{
+				"Content-Type": []string{"application/x-www-form-urlencoded; charset=utf-8"},
+			},
+			body: "Action=RunInstances&ImageId=ami-1&Version=2016-11-15",
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+				Body:   "Action=RunInstances&ImageId=ami-2&Version=2016-11-15",
+			},
+		},
+		"XML whitespace and token": {
+			method: http.MethodPost,
+			url:    "https://route53.amazonaws.com/2013-04-01/hostedzone", //lintignore:AWSAT003
+			headers: http.Header{
+				"Content-Type": []string{"application/xml"},
+			},
+			body: `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Name>example.com</Name><CallerReference>a1</CallerReference></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://route53.amazonaws.com/2013-04-01/hostedzone", //lintignore:AWSAT003
+				Body: `<?xml version="1.0" encoding="UTF-8"?>
+<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <Name>example.com</Name>
+  <CallerReference>b2</CallerReference>
+</CreateHostedZoneRequest>`,
+			},
+			expectMatch: true,
+		},
+		"XML different value": {
+			method: http.MethodPost,
+			url:    "https://route53.amazonaws.com/2013-04-01/hostedzone", //lintignore:AWSAT003
+			headers: http.Header{
+				"Content-Type": []string{"application/xml"},
+			},
+			body: `<CreateHostedZoneRequest><Name>example.com</Name></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://route53.amazonaws.com/2013-04-01/hostedzone", //lintignore:AWSAT003
+				Body:   `<CreateHostedZoneRequest><Name>example.org</Name></CreateHostedZoneRequest>`,
+			},
+		},
+	}
+
+	matcher := vcrRequestMatcher(context.Background())
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequest(testCase.method, testCase.url, strings.NewReader(testCase.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if testCase.body == "" {
+				r.Body = http.NoBody
+			}
+			for k, v := range testCase.headers {
+				r.Header[k] = v
+			}
+
+			if got, want := matcher(r, testCase.recorded), testCase.expectMatch; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	vcrCallerIdentityOperation = "GetCallerIdentity"
+	vcrRedacted                = "REDACTED"
+	vcrSanitizedAccount        = "123456789012"
+)
+
+var (
+	// Request headers that are never saved to a cassette.
+	vcrSensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// Presigned URL query parameters whose values are never saved to a cassette.
+	vcrSensitiveQueryParameters = []string{
+		"X-Amz-Credential",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+	}
+
+	// Response fields whose values are never saved to a cassette.
+	vcrSensitiveFieldsRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`("(?:SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`),
+		regexp.MustCompile(`(<(?:SecretAccessKey|SessionToken)>)[^<]*(</(?:SecretAccessKey|SessionToken)>)`),
+	}
+
+	vcrAccessKeyIDRegexp    = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+	vcrCallerIdentityRegexp = regexp.MustCompile(`<Account>(\d{12})</Account>|"Account"\s*:\s*"(\d{12})"`)
+)
+
+// vcrSanitizer scrubs secrets and account identifiers from recorded interactions before they are saved to a cassette.
+// Account IDs and access key IDs are replaced consistently so that replayed requests match recorded ones.
+type vcrSanitizer struct {
+	lock         sync.Mutex
+	accountIDs   map[string]string // Real -> sanitized.
+	accessKeyIDs map[string]string // Real -> sanitized.
+}
+
+func newVCRSanitizer() *vcrSanitizer {
+	return &vcrSanitizer{
+		accountIDs:   make(map[string]string),
+		accessKeyIDs: make(map[string]string),
+	}
+}
+
+// afterCaptureHook discovers the caller's account IDs from STS GetCallerIdentity responses.
+// It does not modify the interaction, which is returned to the provider while recording.
+func (s *vcrSanitizer) afterCaptureHook(i *cassette.Interaction) error {
+	if !strings.Contains(i.Request.Body, vcrCallerIdentityOperation) && !strings.Contains(i.Request.Headers.Get("X-Amz-Target"), vcrCallerIdentityOperation) {
+		return nil
+	}
+
+	for _, match := range vcrCallerIdentityRegexp.FindAllStringSubmatch(i.Response.Body, -1) {
+		for _, v := range match[1:] {
+			if v != "" {
+				s.addAccountID(v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// beforeSaveHook scrubs the interaction before it's written to the cassette.
+func (s *vcrSanitizer) beforeSaveHook(i *cassette.Interaction) error {
+	// Headers are shared with the live request, so copy before modifying.
+	i.Request.Headers = i.Request.Headers.Clone()
+	for _, k := range vcrSensitiveHeaders {
+		i.Request.Headers.Del(k)
+	}
+
+	if u, err := url.Parse(i.Request.URL); err == nil {
+		query := u.Query()
+		redacted := false
+		for _, k := range vcrSensitiveQueryParameters {
+			if query.Has(k) {
+				query.Set(k, vcrRedacted)
+				redacted = true
+			}
+		}
+		if redacted {
+			u.RawQuery = query.Encode()
+			i.Request.URL = u.String()
+		}
+	}
+
+	for _, re := range vcrSensitiveFieldsRegexps {
+		i.Response.Body = re.ReplaceAllString(i.Response.Body, "${1}"+vcrRedacted+"${2}")
+	}
+
+	i.Request.Host = s.sanitize(i.Request.Host)
+	i.Request.RequestURI = s.sanitize(i.Request.RequestURI)
+	i.Request.URL = s.sanitize(i.Request.URL)
+	i.Request.Body = s.sanitize(i.Request.Body)
+	i.Request.Form = s.sanitizeValues(i.Request.Form)
+	i.Request.Headers = s.sanitizeHeader(i.Request.Headers)
+	i.Response.Body = s.sanitize(i.Response.Body)
+	i.Response.Headers = s.sanitizeHeader(i.Response.Headers)
+
+	return nil
+}
+
+func (s *vcrSanitizer) addAccountID(accountID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.accountIDs[accountID]; ok {
+		return
+	}
+
+	// The first account discovered is the primary account.
+	if len(s.accountIDs) == 0 {
+		s.accountIDs[accountID] = vcrSanitizedAccount
+	} else {
+		s.accountIDs[accountID] = fmt.Sprintf("%012d", len(s.accountIDs))
+	}
+}
+
+func (s *vcrSanitizer) sanitize(v string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for real, sanitized := range s.accountIDs {
+		v = strings.ReplaceAll(v, real, sanitized)
+	}
+
+	return vcrAccessKeyIDRegexp.ReplaceAllStringFunc(v, func(accessKeyID string) string {
+		if sanitized, ok := s.accessKeyIDs[accessKeyID]; ok {
+			return sanitized
+		}
+
+		sanitized := fmt.Sprintf("%sEXAMPLE%09d", accessKeyID[:4], len(s.accessKeyIDs)+1)
+		s.accessKeyIDs[accessKeyID] = sanitized
+
+		return sanitized
+	})
+}
+
+func (s *vcrSanitizer) sanitizeHeader(h http.Header) http.Header {
+	return http.Header(s.sanitizeValues(url.Values(h)))
+}
+
+func (s *vcrSanitizer) sanitizeValues(values url.Values) url.Values {
+	if values == nil {
+		return nil
+	}
+
+	sanitized := make(url.Values, len(values))
+	for k, vs := range values {
+		for _, v := range vs {
+			sanitized[k] = append(sanitized[k], s.sanitize(v))
+		}
+	}
+
+	return sanitized
+}
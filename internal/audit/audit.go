@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package audit records resource changes made by the provider.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Operation is a resource operation that is recorded.
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationDelete Operation = "delete"
+	OperationUpdate Operation = "update"
+)
+
+// Outcome is the result of a recorded resource operation.
+type Outcome string
+
+const (
+	OutcomeFailure Outcome = "failure"
+	OutcomeSuccess Outcome = "success"
+)
+
+// Record is a single audit log record.
+// Only the names of changed attributes are recorded, never their values, so that sensitive values are not written to the log.
+type Record struct {
+	Time              time.Time `json:"time"`
+	ResourceType      string    `json:"resource_type"`
+	Operation         Operation `json:"operation"`
+	ID                string    `json:"id,omitempty"`
+	AccountID         string    `json:"account_id,omitempty"`
+	Region            string    `json:"region,omitempty"`
+	ChangedAttributes []string  `json:"changed_attributes,omitempty"`
+	Outcome           Outcome   `json:"outcome"`
+	Error             string    `json:"error,omitempty"`
+}
+
+// Logger writes audit log records as JSON lines to a file.
+// A Logger is safe for concurrent use.
+type Logger struct {
+	lock sync.Mutex
+	path string
+}
+
+var loggers sync.Map // Path -> *Logger.
+
+// loggerFor returns the Logger for the file at the specified path.
+// Loggers are shared so that records written by multiple provider configurations to the same file do not interleave.
+func loggerFor(path string) *Logger {
+	v, _ := loggers.LoadOrStore(path, NewLogger(path))
+
+	return v.(*Logger)
+}
+
+// NewLogger returns a Logger that appends records to the file at the specified path.
+// The file is created if it does not exist.
+func NewLogger(path string) *Logger {
+	return &Logger{
+		path: path,
+	}
+}
+
+// Path returns the path of the audit log file.
+func (l *Logger) Path() string {
+	return l.path
+}
+
+// Log appends the specified record to the audit log.
+func (l *Logger) Log(r Record) error {
+	if r.Time.IsZero() {
+		r.Time = time.Now().UTC()
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("encoding audit log record: %w", err)
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// The file is opened for each record so that the log can be rotated while the provider is running.
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening audit log (%s): %w", l.path, err)
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return fmt.Errorf("writing audit log (%s): %w", l.path, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing audit log (%s): %w", l.path, err)
+	}
+
+	return nil
+}
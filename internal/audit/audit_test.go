@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestLoggerLog(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger := NewLogger(path)
+
+	const n = 20
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := logger.Log(Record{
+				ResourceType:      "aws_vpc",
+				Operation:         OperationUpdate,
+				ID:                "vpc-12345678",
+				AccountID:         "123456789012",
+				Region:            "us-west-2", //lintignore:AWSAT003
+				ChangedAttributes: []string{"cidr_block", "tags"},
+				Outcome:           OutcomeSuccess,
+			})
+
+			if err != nil {
+				t.Errorf("Log() error = %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var lines int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines++
+
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("line %d: %s", lines, err)
+		}
+
+		if got, want := r.ResourceType, "aws_vpc"; got != want {
+			t.Errorf("ResourceType = %q, want %q", got, want)
+		}
+		if got, want := r.ChangedAttributes, []string{"cidr_block", "tags"}; !slices.Equal(got, want) {
+			t.Errorf("ChangedAttributes = %q, want %q", got, want)
+		}
+		if r.Time.IsZero() {
+			t.Error("Time not set")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := lines, n; got != want {
+		t.Errorf("got %d records, want %d", got, want)
+	}
+}
+
+func TestLoggerLogError(t *testing.T) {
+	t.Parallel()
+
+	logger := NewLogger(filepath.Join(t.TempDir(), "missing", "audit.jsonl"))
+
+	if err := logger.Log(Record{}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+)
+
+// ResourceInterceptor returns an interceptor that records resource creates, updates and deletes in any provider configured audit log.
+func ResourceInterceptor() interceptors.ResourceInterceptor {
+	return interceptors.ResourceInterceptor{
+		When:        interceptors.Finally,
+		Why:         interceptors.Create | interceptors.Update | interceptors.Delete,
+		Interceptor: interceptResource,
+	}
+}
+
+func interceptResource(ctx context.Context, request interceptors.ResourceRequest) (context.Context, error) {
+	c, ok := request.Meta.(*conns.AWSClient)
+	if !ok || c == nil || c.AuditLogPath == "" {
+		return ctx, nil
+	}
+
+	record := Record{
+		ResourceType: request.TypeName,
+		ID:           request.Data.ID(ctx),
+		AccountID:    c.AccountID,
+		Region:       c.Region,
+		Outcome:      OutcomeSuccess,
+	}
+
+	switch request.Why {
+	case interceptors.Create:
+		record.Operation = OperationCreate
+		record.ChangedAttributes = request.Data.ChangedAttributes(ctx)
+	case interceptors.Update:
+		record.Operation = OperationUpdate
+		record.ChangedAttributes = request.Data.ChangedAttributes(ctx)
+	case interceptors.Delete:
+		record.Operation = OperationDelete
+	default:
+		return ctx, nil
+	}
+
+	if err := request.Err; err != nil {
+		record.Outcome = OutcomeFailure
+		record.Error = err.Error()
+	}
+
+	if err := loggerFor(c.AuditLogPath).Log(record); err != nil {
+		return ctx, interceptors.NewWarning(fmt.Errorf("recording %s (%s) %s in audit log: %w", request.TypeName, record.ID, record.Operation, err))
+	}
+
+	return ctx, nil
+}
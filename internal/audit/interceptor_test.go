@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+)
+
+type mockResourceData struct {
+	id                string
+	changedAttributes []string
+}
+
+func (d mockResourceData) ID(context.Context) string {
+	return d.id
+}
+
+func (d mockResourceData) ChangedAttributes(context.Context) []string {
+	return d.changedAttributes
+}
+
+func (d mockResourceData) ConfigMap(context.Context, string) (map[string]*string, bool) {
+	return nil, false
+}
+
+func TestResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		AuditLogPath: path,
+		Region:       "us-west-2", //lintignore:AWSAT003
+	}
+	data := mockResourceData{
+		id:                "test-id",
+		changedAttributes: []string{"name", "tags"},
+	}
+
+	s := interceptors.ResourceInterceptors{ResourceInterceptor()}
+
+	for _, why := range []interceptors.Why{interceptors.Create, interceptors.Read, interceptors.Update, interceptors.Delete} {
+		request := interceptors.ResourceRequest{
+			TypeName: "aws_test",
+			Why:      why,
+			Meta:     meta,
+			Data:     data,
+		}
+
+		errs := s.Run(ctx, request, func(context.Context) error {
+			if why == interceptors.Delete {
+				return errors.New("access denied")
+			}
+			return nil
+		})
+
+		if len(errs) > 0 {
+			t.Fatalf("Run() errs = %v", errs)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, r)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(records), 3; got != want {
+		t.Fatalf("got %d records, want %d", got, want)
+	}
+
+	for i, want := range []Record{
+		{Operation: OperationCreate, ChangedAttributes: data.changedAttributes, Outcome: OutcomeSuccess},
+		{Operation: OperationUpdate, ChangedAttributes: data.changedAttributes, Outcome: OutcomeSuccess},
+		{Operation: OperationDelete, Outcome: OutcomeFailure, Error: "access denied"},
+	} {
+		got := records[i]
+
+		if got.ResourceType != "aws_test" || got.ID != "test-id" || got.AccountID != meta.AccountID || got.Region != meta.Region {
+			t.Errorf("record %d = %+v, unexpected resource", i, got)
+		}
+		if got.Operation != want.Operation {
+			t.Errorf("record %d Operation = %q, want %q", i, got.Operation, want.Operation)
+		}
+		if !slices.Equal(got.ChangedAttributes, want.ChangedAttributes) {
+			t.Errorf("record %d ChangedAttributes = %q, want %q", i, got.ChangedAttributes, want.ChangedAttributes)
+		}
+		if got.Outcome != want.Outcome {
+			t.Errorf("record %d Outcome = %q, want %q", i, got.Outcome, want.Outcome)
+		}
+		if got.Error != want.Error {
+			t.Errorf("record %d Error = %q, want %q", i, got.Error, want.Error)
+		}
+	}
+}
+
+func TestResourceInterceptorNoAuditLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := interceptors.ResourceInterceptors{ResourceInterceptor()}
+
+	for _, meta := range []any{nil, (*conns.AWSClient)(nil), &conns.AWSClient{}} {
+		errs := s.Run(ctx, interceptors.ResourceRequest{Why: interceptors.Create, Meta: meta}, func(context.Context) error {
+			return nil
+		})
+
+		if len(errs) > 0 {
+			t.Errorf("Run() errs = %v", errs)
+		}
+	}
+}
+
+func TestResourceInterceptorWriteError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		AuditLogPath: filepath.Join(t.TempDir(), "missing", "audit.jsonl"),
+	}
+	s := interceptors.ResourceInterceptors{ResourceInterceptor()}
+
+	errs := s.Run(ctx, interceptors.ResourceRequest{Why: interceptors.Delete, Meta: meta, Data: mockResourceData{}}, func(context.Context) error {
+		return nil
+	})
+
+	if got, want := len(errs), 1; got != want {
+		t.Fatalf("length of errs = %d, want %d", got, want)
+	}
+	if !interceptors.IsWarning(errs[0]) {
+		t.Errorf("IsWarning(%v) = false, want true", errs[0])
+	}
+}
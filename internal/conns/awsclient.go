@@ -20,6 +20,7 @@ import (
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/smithy-go/middleware"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -29,20 +30,24 @@ import (
 
 type AWSClient struct {
 	AccountID         string
+	AuditLogPath      string
 	DefaultTagsConfig *tftags.DefaultConfig
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Partition         string
 	Region            string
 	ServicePackages   map[string]ServicePackage
+	TagPolicyConfig   *tftags.PolicyConfig
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
+	concurrencyLimiter        *concurrencyLimiter
 	conns                     map[string]any
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	rateLimiters              map[string]*adaptiveRateLimiter // Service package name -> limiter.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -263,6 +268,22 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	if sp, ok := c.ServicePackages[servicePackageName]; ok && c.awsConfig != nil {
+		var apiOptions []func(*middleware.Stack) error
+		if c.concurrencyLimiter != nil {
+			apiOptions = append(apiOptions, c.concurrencyLimiter.apiOptions(ctx, sp)...)
+		}
+		if v, ok := c.rateLimiters[servicePackageName]; ok {
+			apiOptions = append(apiOptions, v.apiOptions()...)
+		}
+
+		if len(apiOptions) > 0 {
+			cfg := c.awsConfig.Copy()
+			cfg.APIOptions = append(cfg.APIOptions, apiOptions...)
+			m["aws_sdkv2_config"] = &cfg
+		}
+	}
+
 	return m
 }
 
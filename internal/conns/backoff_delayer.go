@@ -27,7 +27,7 @@ func (c *v1CompatibleBackoff) BackoffDelay(attempt int, err error) (time.Duratio
 	)
 	minDelay := defaultMinRetryDelay
 
-	if retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool() {
+	if isThrottleError(err) {
 		minDelay = defaultMinThrottleDelay
 	}
 
@@ -48,6 +48,12 @@ func (c *v1CompatibleBackoff) BackoffDelay(attempt int, err error) (time.Duratio
 	return delay, nil
 }
 
+// isThrottleError returns whether the specified error is an AWS API throttling error.
+// Throttling errors are also used to adapt any client-side rate limit.
+func isThrottleError(err error) bool {
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
+
 func getJitterDelay(duration time.Duration) time.Duration {
 	return time.Duration(seededRand.Int63n(int64(duration)) + int64(duration))
 }
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	concurrencyLimitMiddlewareID = "TerraformConcurrencyLimit"
+)
+
+// concurrencyLimiter limits the number of concurrent calls to AWS API operations.
+// Limits are declared by service packages and can be overridden in provider configuration.
+type concurrencyLimiter struct {
+	lock       sync.Mutex
+	overrides  map[string]map[string]int // From provider configuration. Service package name -> operation -> limit.
+	semaphores map[string]map[string]*tfsync.Semaphore
+}
+
+func newConcurrencyLimiter(overrides map[string]map[string]int) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		overrides:  overrides,
+		semaphores: make(map[string]map[string]*tfsync.Semaphore),
+	}
+}
+
+// servicePackageSemaphores returns the per-operation semaphores for the specified service package.
+// The semaphores are shared by all API clients for the service package.
+func (l *concurrencyLimiter) servicePackageSemaphores(ctx context.Context, sp ServicePackage) map[string]*tfsync.Semaphore {
+	servicePackageName := sp.ServicePackageName()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if semaphores, ok := l.semaphores[servicePackageName]; ok {
+		return semaphores
+	}
+
+	limits := make(map[string]int)
+	if v, ok := sp.(interface {
+		ConcurrencyLimits(context.Context) []*types.ServicePackageConcurrencyLimit
+	}); ok {
+		for _, v := range v.ConcurrencyLimits(ctx) {
+			limits[v.Operation] = v.Limit
+		}
+	}
+	for operation, limit := range l.overrides[servicePackageName] {
+		limits[operation] = limit
+	}
+
+	semaphores := make(map[string]*tfsync.Semaphore)
+	for operation, limit := range limits {
+		if limit > 0 {
+			semaphores[operation] = tfsync.NewSemaphore(limit)
+		}
+	}
+	l.semaphores[servicePackageName] = semaphores
+
+	return semaphores
+}
+
+// apiOptions returns AWS SDK for Go v2 API options that limit concurrent calls to the specified service package's operations.
+func (l *concurrencyLimiter) apiOptions(ctx context.Context, sp ServicePackage) []func(*middleware.Stack) error {
+	semaphores := l.servicePackageSemaphores(ctx, sp)
+	if len(semaphores) == 0 {
+		return nil
+	}
+
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// The operation name is only available once the operation's service metadata is registered.
+			return stack.Initialize.Insert(concurrencyLimitMiddleware(semaphores), registerServiceMetadataMiddlewareID, middleware.After)
+		},
+	}
+}
+
+func concurrencyLimitMiddleware(semaphores map[string]*tfsync.Semaphore) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(concurrencyLimitMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		operation := awsmiddleware.GetOperationName(ctx)
+		semaphore, ok := semaphores[operation]
+		if !ok {
+			return next.HandleInitialize(ctx, in)
+		}
+
+		if !semaphore.TryAcquire() {
+			ctx := tflog.SetField(ctx, "tf_aws.concurrency_limit", semaphore.Limit())
+			ctx = tflog.SetField(ctx, "tf_aws.operation", operation)
+			tflog.Debug(ctx, "Waiting for concurrency limit")
+
+			start := time.Now()
+			if err := semaphore.Acquire(ctx); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for %s concurrency limit (%d): %w", operation, semaphore.Limit(), err)
+			}
+
+			tflog.Debug(ctx, "Acquired concurrency limit", map[string]any{
+				"tf_aws.concurrency_limit_wait_duration": time.Since(start).String(),
+			})
+		}
+		defer semaphore.Release()
+
+		return next.HandleInitialize(ctx, in)
+	})
+}
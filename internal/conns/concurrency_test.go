@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type concurrencyLimitedServicePackage struct {
+	ServicePackage
+}
+
+func (concurrencyLimitedServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (concurrencyLimitedServicePackage) ConcurrencyLimits(context.Context) []*types.ServicePackageConcurrencyLimit {
+	return []*types.ServicePackageConcurrencyLimit{
+		{Operation: "CreateCluster", Limit: 10},
+		{Operation: "CreateNodegroup", Limit: 5},
+		{Operation: "CreateAddon", Limit: 0},
+	}
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newConcurrencyLimiter(map[string]map[string]int{
+		"test": {
+			"CreateCluster":   2,
+			"CreateNodegroup": 0,
+			"DeleteCluster":   1,
+		},
+	})
+	sp := concurrencyLimitedServicePackage{}
+
+	semaphores := l.servicePackageSemaphores(ctx, sp)
+
+	expected := map[string]int{
+		"CreateCluster": 2,
+		"DeleteCluster": 1,
+	}
+	if got, want := len(semaphores), len(expected); got != want {
+		t.Fatalf("expected %d semaphores, got %d", want, got)
+	}
+	for operation, limit := range expected {
+		semaphore, ok := semaphores[operation]
+		if !ok {
+			t.Fatalf("expected semaphore for %s", operation)
+		}
+		if got := semaphore.Limit(); got != limit {
+			t.Errorf("expected %s limit %d, got %d", operation, limit, got)
+		}
+	}
+
+	if got, want := l.servicePackageSemaphores(ctx, sp)["CreateCluster"], semaphores["CreateCluster"]; got != want {
+		t.Error("expected semaphores to be shared")
+	}
+
+	if got, want := len(l.apiOptions(ctx, sp)), 1; got != want {
+		t.Errorf("expected %d API options, got %d", want, got)
+	}
+}
+
+func TestConcurrencyLimiterAPIOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newConcurrencyLimiter(map[string]map[string]int{
+		"test": {
+			"GetCallerIdentity": 1,
+		},
+	})
+
+	received := make(chan struct{}, 2)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+		<-release
+		testGetCallerIdentityHandler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	// Cleanups run last-in, first-out, so blocked calls are released before the server is closed.
+	var releaseOnce sync.Once
+	releaseCalls := func() { releaseOnce.Do(func() { close(release) }) }
+	t.Cleanup(releaseCalls)
+
+	client := newTestSTSClient(server, l.apiOptions(ctx, concurrencyLimitedServicePackage{}))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+			errs <- err
+		}()
+	}
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a call to be sent")
+	}
+
+	// The second call waits for the first to complete.
+	select {
+	case <-received:
+		t.Fatal("expected the second call to wait for the concurrency limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	releaseCalls()
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the second call to be sent")
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+}
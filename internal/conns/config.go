@@ -29,8 +29,11 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallGuard                   APICallGuard // Called before each AWS API operation. Used by sweepers.
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
+	ConcurrencyLimits              map[string]map[string]int // Service package name -> operation -> limit.
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -45,6 +48,7 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     map[string]RateLimit // Service package name -> limit.
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -57,6 +61,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -183,6 +188,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.APICallGuard != nil {
+		session.Handlers.Validate.PushFrontNamed(c.APICallGuard.handler())
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -210,20 +219,30 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	client.AuditLogPath = c.AuditLogPath
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
+	if c.APICallGuard != nil {
+		cfg.APIOptions = append(cfg.APIOptions, c.APICallGuard.apiOptions()...)
+	}
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
+	client.concurrencyLimiter = newConcurrencyLimiter(c.ConcurrencyLimits)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = make(map[string]*adaptiveRateLimiter, len(c.RateLimits))
+	for servicePackageName, limit := range c.RateLimits {
+		client.rateLimiters[servicePackageName] = newAdaptiveRateLimiter(limit)
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	apiCallGuardHandlerName  = "TerraformAPICallGuard"
+	apiCallGuardMiddlewareID = "TerraformAPICallGuard"
+
+	// registerServiceMetadataMiddlewareID is the ID of the AWS SDK for Go v2 middleware that makes the operation name available to later middleware.
+	registerServiceMetadataMiddlewareID = "RegisterServiceMetadata"
+)
+
+// APICallGuard is called before each AWS API operation is sent.
+// A non-nil error fails the call without sending it.
+type APICallGuard func(ctx context.Context, operation string) error
+
+// apiOptions returns AWS SDK for Go v2 API options that guard all calls.
+func (g APICallGuard) apiOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// The operation name is only available once the operation's service metadata is registered.
+			return stack.Initialize.Insert(middleware.InitializeMiddlewareFunc(apiCallGuardMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				if err := g(ctx, awsmiddleware.GetOperationName(ctx)); err != nil {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, err
+				}
+
+				return next.HandleInitialize(ctx, in)
+			}), registerServiceMetadataMiddlewareID, middleware.After)
+		},
+	}
+}
+
+// handler returns an AWS SDK for Go v1 request handler that guards all calls.
+// The handler is intended to be added to the Validate handler list, whose errors are not retried.
+func (g APICallGuard) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: apiCallGuardHandlerName,
+		Fn: func(r *request.Request) {
+			if err := g(r.Context(), r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+var errTestAPICallGuard = errors.New("guarded")
+
+func testAPICallGuard(_ context.Context, operation string) error {
+	if operation == "DeleteWidget" {
+		return errTestAPICallGuard
+	}
+
+	return nil
+}
+
+// newTestSTSClient returns an STS client that sends requests to the specified test server.
+func newTestSTSClient(server *httptest.Server, apiOptions []func(*middleware.Stack) error) *sts.Client {
+	return sts.New(sts.Options{
+		APIOptions:   apiOptions,
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  aws.AnonymousCredentials{},
+		HTTPClient:   server.Client(),
+		Region:       "us-west-2", //lintignore:AWSAT003
+	})
+}
+
+// testGetCallerIdentityHandler responds to all requests with a successful GetCallerIdentity response.
+func testGetCallerIdentityHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/xml")
+	fmt.Fprint(w, `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`) //lintignore:AWSAT005
+}
+
+func TestAPICallGuardAPIOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		guardedOperation string
+		wantErr          error
+	}{
+		"allowed": {
+			guardedOperation: "DeleteWidget",
+		},
+		"guarded": {
+			guardedOperation: "GetCallerIdentity",
+			wantErr:          errTestAPICallGuard,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var sent atomic.Bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sent.Store(true)
+				testGetCallerIdentityHandler(w, r)
+			}))
+			t.Cleanup(server.Close)
+
+			var operations []string
+			guard := APICallGuard(func(_ context.Context, operation string) error {
+				operations = append(operations, operation)
+				if operation == testCase.guardedOperation {
+					return errTestAPICallGuard
+				}
+
+				return nil
+			})
+
+			_, err := newTestSTSClient(server, guard.apiOptions()).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+			if got, want := err, testCase.wantErr; !errors.Is(got, want) {
+				t.Errorf("expected error %v, got %v", want, got)
+			}
+			if got, want := sent.Load(), testCase.wantErr == nil; got != want {
+				t.Errorf("expected sent %t, got %t", want, got)
+			}
+			if got, want := operations, []string{"GetCallerIdentity"}; !slices.Equal(got, want) {
+				t.Errorf("expected guarded operations %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestAPICallGuardHandler(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operation string
+		wantErr   error
+	}{
+		"allowed": {
+			operation: "DescribeWidgets",
+		},
+		"guarded": {
+			operation: "DeleteWidget",
+			wantErr:   errTestAPICallGuard,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &request.Request{
+				HTTPRequest: &http.Request{},
+				Operation:   &request.Operation{Name: testCase.operation},
+			}
+			r.SetContext(context.Background())
+
+			APICallGuard(testAPICallGuard).handler().Fn(r)
+
+			if got, want := r.Error, testCase.wantErr; !errors.Is(got, want) {
+				t.Errorf("expected error %v, got %v", want, got)
+			}
+		})
+	}
+}
@@ -4,46 +4,279 @@
 package conns
 
 import (
+	"context"
+	"fmt"
+	"runtime"
 	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
 var GlobalMutexKV = newMutexKV()
 
-// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// mutexKV is a simple key/value store for arbitrary read/write mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+// Mutexes are reference counted and removed from the store once no collaborator holds or awaits them.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*mutexKVEntry
 }
 
-// Locks the mutex for the given key. Caller is responsible for calling Unlock
-// for the same key
+// Locks the mutex for the given key for writing. Caller is responsible for calling Unlock
+// for the same key.
+// Contention is not logged as no logger is available; use LockContext where a context is available
 func (m *mutexKV) Lock(key string) {
-	m.get(key).Lock()
+	// A background context is never cancelled so the only possible outcome is success.
+	_ = m.lockContext(context.Background(), key, false)
+}
+
+// LockContext locks the mutex for the given key for writing, waiting until the lock is acquired
+// or the context is done. Caller is responsible for calling Unlock for the same key if, and only if,
+// no error is returned
+func (m *mutexKV) LockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key, false)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
-	m.get(key).Unlock()
+	m.unlock(key, false)
+}
+
+// RLock locks the mutex for the given key for reading. Caller is responsible for calling RUnlock
+// for the same key
+func (m *mutexKV) RLock(key string) {
+	_ = m.lockContext(context.Background(), key, true)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// RLockContext locks the mutex for the given key for reading, waiting until the lock is acquired
+// or the context is done. Caller is responsible for calling RUnlock for the same key if, and only if,
+// no error is returned
+func (m *mutexKV) RLockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key, true)
+}
+
+// RUnlock the mutex for the given key. Caller must have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	m.unlock(key, true)
+}
+
+func (m *mutexKV) lockContext(ctx context.Context, key string, read bool) error {
+	entry := m.acquire(key)
+	holder := caller()
+
+	if entry.tryLock(read, holder) {
+		return nil
+	}
+
+	ctx = tflog.SetField(ctx, "tf_aws.mutex_key", key)
+	tflog.Debug(ctx, "Waiting for lock", map[string]any{
+		"tf_aws.mutex_holder": entry.currentHolder(),
+		"tf_aws.mutex_read":   read,
+	})
+
+	start := time.Now()
+	if err := entry.lockContext(ctx, read, holder); err != nil {
+		m.release(key)
+
+		tflog.Warn(ctx, "Abandoned waiting for lock", map[string]any{
+			"tf_aws.mutex_holder":        entry.currentHolder(),
+			"tf_aws.mutex_wait_duration": time.Since(start).String(),
+		})
+
+		return fmt.Errorf("waiting for lock (%s): %w", key, err)
+	}
+
+	tflog.Debug(ctx, "Acquired lock", map[string]any{
+		"tf_aws.mutex_wait_duration": time.Since(start).String(),
+	})
+
+	return nil
+}
+
+func (m *mutexKV) unlock(key string, read bool) {
+	m.lock.Lock()
+	entry, ok := m.store[key]
+	m.lock.Unlock()
+
+	if !ok {
+		panic(fmt.Sprintf("unlock of unlocked mutex (%s)", key))
+	}
+
+	entry.unlock(read)
+	m.release(key)
+}
+
+// acquire returns the mutex for the given key, no guarantee of its lock status, incrementing its reference count
+func (m *mutexKV) acquire(key string) *mutexKVEntry {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	entry, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		entry = newMutexKVEntry()
+		m.store[key] = entry
 	}
-	return mutex
+	entry.refs++
+	return entry
+}
+
+// release decrements the reference count of the mutex for the given key, removing it once unreferenced
+func (m *mutexKV) release(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entry, ok := m.store[key]
+	if !ok {
+		return
+	}
+	entry.refs--
+	if entry.refs <= 0 {
+		delete(m.store, key)
+	}
+}
+
+// len returns the number of mutexes in the store
+func (m *mutexKV) len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.store)
 }
 
 // Returns a properly initialized MutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*mutexKVEntry),
+	}
+}
+
+// mutexKVEntry is a read/write mutex whose acquisition can be abandoned.
+type mutexKVEntry struct {
+	refs int // Guarded by mutexKV.lock.
+
+	lock           sync.Mutex
+	changed        chan struct{} // Closed, and replaced, whenever the lock is released.
+	writer         bool
+	readers        int
+	writersWaiting int
+	holder         string
+}
+
+func newMutexKVEntry() *mutexKVEntry {
+	return &mutexKVEntry{
+		changed: make(chan struct{}),
+	}
+}
+
+// tryLock attempts to lock without waiting.
+func (e *mutexKVEntry) tryLock(read bool, holder string) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.tryLockLocked(read, holder)
+}
+
+func (e *mutexKVEntry) tryLockLocked(read bool, holder string) bool {
+	if e.writer {
+		return false
+	}
+
+	if read {
+		// Waiting writers take priority over new readers.
+		if e.writersWaiting > 0 {
+			return false
+		}
+		e.readers++
+		if e.readers == 1 {
+			e.holder = holder
+		}
+		return true
+	}
+
+	if e.readers > 0 {
+		return false
+	}
+	e.writer = true
+	e.holder = holder
+	return true
+}
+
+// lockContext waits until the lock is acquired or the context is done.
+func (e *mutexKVEntry) lockContext(ctx context.Context, read bool, holder string) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if !read {
+		e.writersWaiting++
+	}
+
+	for {
+		if !read {
+			// Let tryLockLocked see only other waiting writers.
+			e.writersWaiting--
+		}
+		if e.tryLockLocked(read, holder) {
+			return nil
+		}
+		if !read {
+			e.writersWaiting++
+		}
+		changed := e.changed
+		e.lock.Unlock()
+
+		select {
+		case <-changed:
+			e.lock.Lock()
+		case <-ctx.Done():
+			e.lock.Lock()
+			if !read {
+				e.writersWaiting--
+				// Readers may have been held back by this writer.
+				e.broadcastLocked()
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+func (e *mutexKVEntry) unlock(read bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if read {
+		if e.readers == 0 {
+			panic("runlock of unlocked mutex")
+		}
+		e.readers--
+		if e.readers == 0 {
+			e.holder = ""
+		}
+	} else {
+		if !e.writer {
+			panic("unlock of unlocked mutex")
+		}
+		e.writer = false
+		e.holder = ""
+	}
+
+	e.broadcastLocked()
+}
+
+func (e *mutexKVEntry) broadcastLocked() {
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+func (e *mutexKVEntry) currentHolder() string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.holder
+}
+
+// caller returns the location of the code acquiring a lock.
+func caller() string {
+	// Skip caller, lockContext and the exported Lock method.
+	if _, file, line, ok := runtime.Caller(3); ok {
+		return fmt.Sprintf("%s:%d", file, line)
 	}
+	return ""
 }
@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 )
@@ -74,3 +76,118 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContext(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVRLock(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.RLock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.RLock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second read lock blocked. This shouldn't happen.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); err == nil {
+		t.Fatal("Write lock was able to be taken while read locked. This shouldn't happen.")
+	}
+
+	mkv.RUnlock("foo")
+	mkv.RUnlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVRLockWaitingWriter(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.RLock("foo")
+
+	writerCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(writerCh)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.RLockContext(ctx, "foo"); err == nil {
+		t.Fatal("Read lock was able to be taken while a writer was waiting. This shouldn't happen.")
+	}
+
+	mkv.RUnlock("foo")
+
+	select {
+	case <-writerCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Write lock blocked after read unlock. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVCleanup(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+	mkv.RLock("bar")
+
+	if got, want := mkv.len(), 2; got != want {
+		t.Fatalf("expected %d mutexes, got %d", want, got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_ = mkv.LockContext(ctx, "foo")
+
+	mkv.Unlock("foo")
+	mkv.RUnlock("bar")
+
+	if got, want := mkv.len(), 0; got != want {
+		t.Fatalf("expected %d mutexes, got %d", want, got)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	rateLimitMiddlewareID = "TerraformRateLimit"
+
+	// Multiplicative decrease on throttling, additive increase on success.
+	rateLimitDecreaseFactor = 0.5
+	rateLimitIncreaseFactor = 0.05
+	// The current rate never drops below this fraction of the configured rate.
+	rateLimitMinimumFactor = 0.05
+)
+
+// RateLimit represents a client-side rate limit on calls to a service's AWS API.
+type RateLimit struct {
+	Rate  float64 // The maximum sustained number of requests per second.
+	Burst int     // The maximum number of requests that can be made at once. Defaults to the ceiling of Rate.
+}
+
+// adaptiveRateLimiter is a token bucket rate limiter.
+// The rate at which the bucket refills is halved each time a request is throttled and
+// gradually restored to the configured rate as requests succeed.
+type adaptiveRateLimiter struct {
+	lock    sync.Mutex
+	maxRate float64
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	now     func() time.Time
+}
+
+func newAdaptiveRateLimiter(limit RateLimit) *adaptiveRateLimiter {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(limit.Rate))
+	}
+
+	return &adaptiveRateLimiter{
+		maxRate: limit.Rate,
+		rate:    limit.Rate,
+		burst:   burst,
+		tokens:  burst,
+		now:     time.Now,
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before using it.
+func (l *adaptiveRateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	// Tokens may go negative, queueing callers behind one another.
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wait blocks until a request may be made or the context is done.
+func (l *adaptiveRateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Return the token.
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// Throttled reduces the current rate in response to a throttling error.
+func (l *adaptiveRateLimiter) Throttled() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.rate = math.Max(l.rate*rateLimitDecreaseFactor, l.maxRate*rateLimitMinimumFactor)
+}
+
+// Succeeded restores the current rate towards the configured rate.
+func (l *adaptiveRateLimiter) Succeeded() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.rate = math.Min(l.rate+l.maxRate*rateLimitIncreaseFactor, l.maxRate)
+}
+
+// Rate returns the current rate.
+func (l *adaptiveRateLimiter) Rate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.rate
+}
+
+// apiOptions returns AWS SDK for Go v2 API options that rate limit each attempt of an API call.
+func (l *adaptiveRateLimiter) apiOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Run after the retry middleware so that each attempt is rate limited.
+			return stack.Finalize.Insert(rateLimitMiddleware(l), "Retry", middleware.After)
+		},
+	}
+}
+
+func rateLimitMiddleware(l *adaptiveRateLimiter) middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc(rateLimitMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.Wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for rate limit: %w", err)
+		}
+
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		switch {
+		case err == nil:
+			l.Succeeded()
+		case isThrottleError(err):
+			l.Throttled()
+			tflog.Debug(ctx, "Reduced rate limit after throttling", map[string]any{
+				"tf_aws.rate_limit": l.Rate(),
+			})
+		}
+
+		return out, metadata, err
+	})
+}
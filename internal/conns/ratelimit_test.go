@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	l := newAdaptiveRateLimiter(RateLimit{Rate: 2, Burst: 2})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("reservation %d: expected no delay, got %s", i, got)
+		}
+	}
+
+	if got, want := l.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("expected delay %s, got %s", want, got)
+	}
+	if got, want := l.reserve(), 1*time.Second; got != want {
+		t.Errorf("expected delay %s, got %s", want, got)
+	}
+
+	now = now.Add(10 * time.Second)
+
+	if got := l.reserve(); got != 0 {
+		t.Errorf("expected no delay after refill, got %s", got)
+	}
+}
+
+func TestAdaptiveRateLimiterAdapt(t *testing.T) {
+	t.Parallel()
+
+	l := newAdaptiveRateLimiter(RateLimit{Rate: 10})
+
+	if got, want := l.burst, 10.0; got != want {
+		t.Errorf("expected default burst %f, got %f", want, got)
+	}
+
+	l.Throttled()
+	if got, want := l.Rate(), 5.0; got != want {
+		t.Errorf("expected rate %f after throttling, got %f", want, got)
+	}
+
+	for i := 0; i < 10; i++ {
+		l.Throttled()
+	}
+	if got, want := l.Rate(), 10*rateLimitMinimumFactor; got != want {
+		t.Errorf("expected minimum rate %f, got %f", want, got)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Succeeded()
+	}
+	if got, want := l.Rate(), 10.0; got != want {
+		t.Errorf("expected rate %f after successes, got %f", want, got)
+	}
+}
+
+func TestAdaptiveRateLimiterWaitCancelled(t *testing.T) {
+	t.Parallel()
+
+	l := newAdaptiveRateLimiter(RateLimit{Rate: 0.1, Burst: 1})
+
+	ctx := context.Background()
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+}
@@ -66,6 +66,13 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For offline acceptance testing, the URL of a local AWS API stand-in used for all services
+	OfflineEndpoint = "TF_ACC_OFFLINE_ENDPOINT"
+
+	// For offline acceptance testing, the AWS account ID reported by the provider.
+	// Defaults to 000000000000.
+	OfflineAccountID = "TF_ACC_OFFLINE_ACCOUNT_ID"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +91,27 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for filtering resources deleted by resource sweepers
+const (
+	// If true, list the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of tags, as key=value or key, whose resources are kept
+	SweepKeepTags = "TF_AWS_SWEEP_KEEP_TAGS"
+
+	// Comma-separated list of name prefixes. Only resources whose names start with one of them are deleted
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Only resources created at least this long ago, as a Go duration, are deleted
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+)
+
+// Custom environment variables used for debugging the Terraform AWS Provider
+const (
+	// If true, log a warning whenever a semantic equality check suppresses a difference
+	LogSuppressedDiffs = "TF_AWS_LOG_SUPPRESSED_DIFFS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
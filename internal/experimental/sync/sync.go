@@ -1,6 +1,10 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+// Package sync limits the concurrency of acceptance tests.
+//
+// Deprecated: Use the internal/sync package's Semaphore. AWS API operations are limited
+// using the provider's concurrency_limit configuration and service package ConcurrencyLimits.
 package sync
 
 import (
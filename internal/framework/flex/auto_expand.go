@@ -87,6 +87,11 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 		return diags
 	}
 
+	if c, ok := findConverter(valFrom.Type(), vTo.Type()); ok && c.expand != nil {
+		diags.Append(c.expand(ctx, valFrom, vTo)...)
+		return diags
+	}
+
 	switch vFrom := vFrom.(type) {
 	// Primitive types.
 	case basetypes.BoolValuable:
@@ -1201,6 +1201,122 @@ func TestExpandOptions(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandFieldTags(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		ID          types.String `tfsdk:"id" autoflex:"name=VpcId"`
+		Description types.String `tfsdk:"description" autoflex:",omitempty"`
+		Name        types.String `tfsdk:"name" autoflex:"-"`
+		Count       types.Int64  `tfsdk:"count" autoflex:"name=MaxCount,omitempty"`
+	}
+	type aws01 struct {
+		Id          *string
+		VpcId       *string
+		Description *string
+		Name        *string
+		MaxCount    *int64
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "explicit names",
+			Source: &tf01{
+				ID:          types.StringValue("vpc-1"),
+				Description: types.StringValue("test"),
+				Name:        types.StringValue("ignored"),
+				Count:       types.Int64Value(2),
+			},
+			Target: &aws01{},
+			WantTarget: &aws01{
+				VpcId:       aws.String("vpc-1"),
+				Description: aws.String("test"),
+				MaxCount:    aws.Int64(2),
+			},
+		},
+		{
+			TestName: "omit empty",
+			Source: &tf01{
+				ID:          types.StringValue(""),
+				Description: types.StringValue(""),
+				Count:       types.Int64Value(0),
+			},
+			Target: &aws01{},
+			WantTarget: &aws01{
+				VpcId: aws.String(""),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+type testFlexIntEnum int32
+
+const (
+	testFlexIntEnumLow  testFlexIntEnum = 1
+	testFlexIntEnumHigh testFlexIntEnum = 2
+)
+
+func init() {
+	RegisterConverter(
+		func(_ context.Context, v types.String) (testFlexIntEnum, diag.Diagnostics) {
+			if v.ValueString() == "HIGH" {
+				return testFlexIntEnumHigh, nil
+			}
+			return testFlexIntEnumLow, nil
+		},
+		func(_ context.Context, v testFlexIntEnum) (types.String, diag.Diagnostics) {
+			if v == testFlexIntEnumHigh {
+				return types.StringValue("HIGH"), nil
+			}
+			return types.StringValue("LOW"), nil
+		},
+	)
+}
+
+func TestExpandConverters(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Priority     types.String `tfsdk:"priority"`
+		CreationTime types.Int64  `tfsdk:"creation_time"`
+		DeletionTime types.Int64  `tfsdk:"deletion_time"`
+	}
+	type aws01 struct {
+		Priority     testFlexIntEnum
+		CreationTime *time.Time
+		DeletionTime time.Time
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "custom and epoch converters",
+			Source: &tf01{
+				Priority:     types.StringValue("HIGH"),
+				CreationTime: types.Int64Value(1380101641),
+				DeletionTime: types.Int64Value(1380101642),
+			},
+			Target: &aws01{},
+			WantTarget: &aws01{
+				Priority:     testFlexIntEnumHigh,
+				CreationTime: aws.Time(time.Date(2013, time.September, 25, 9, 34, 1, 0, time.UTC)),
+				DeletionTime: time.Date(2013, time.September, 25, 9, 34, 2, 0, time.UTC),
+			},
+		},
+		{
+			TestName: "null values",
+			Source: &tf01{
+				Priority:     types.StringNull(),
+				CreationTime: types.Int64Null(),
+				DeletionTime: types.Int64Null(),
+			},
+			Target:     &aws01{},
+			WantTarget: &aws01{},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -1371,6 +1487,98 @@ func TestExpandInterface(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "nested object member",
+			Source: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &testFlexTFUnion{
+					Static: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+						Field1: types.StringValue("a"),
+					}),
+					Label: types.StringNull(),
+				}),
+			},
+			Target: &testFlexAWSUnionSingle{},
+			WantTarget: &testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberStatic{
+					Value: TestFlexAWS01{Field1: "a"},
+				},
+			},
+		},
+		{
+			TestName: "primitive member",
+			Source: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &testFlexTFUnion{
+					Static: fwtypes.NewListNestedObjectValueOfValueSliceMust[TestFlexTF01](ctx, nil),
+					Label:  types.StringValue("b"),
+				}),
+			},
+			Target: &testFlexAWSUnionSingle{},
+			WantTarget: &testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberName{
+					Value: "b",
+				},
+			},
+		},
+		{
+			TestName: "no member",
+			Source: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &testFlexTFUnion{
+					Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Label:  types.StringNull(),
+				}),
+			},
+			Target:     &testFlexAWSUnionSingle{},
+			WantTarget: &testFlexAWSUnionSingle{},
+		},
+		{
+			TestName: "multiple members",
+			Source: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &testFlexTFUnion{
+					Static: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+						Field1: types.StringValue("a"),
+					}),
+					Label: types.StringValue("b"),
+				}),
+			},
+			Target: &testFlexAWSUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Combination", `At most one of "static", "label" can be configured, got: "static", "label".`),
+				diag.NewErrorDiagnostic("AutoFlEx", "convert (Field1)"),
+				diag.NewErrorDiagnostic("AutoFlEx", "Expand[*flex.testFlexTFUnionListNestedObject, *flex.testFlexAWSUnionSingle]"),
+			},
+		},
+		{
+			TestName: "slice",
+			Source: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+						Label:  types.StringValue("a"),
+					},
+					{
+						Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+						Label:  types.StringValue("b"),
+					},
+				}),
+			},
+			Target: &testFlexAWSUnionSlice{},
+			WantTarget: &testFlexAWSUnionSlice{
+				Field1: []testFlexAWSUnion{
+					&testFlexAWSUnionMemberName{Value: "a"},
+					&testFlexAWSUnionMemberName{Value: "b"},
+				},
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 func testFlexAWSInterfaceInterfacePtr(v testFlexAWSInterfaceInterface) *testFlexAWSInterfaceInterface { // nosemgrep:ci.aws-in-func-name
 	return &v
 }
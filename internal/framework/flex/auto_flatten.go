@@ -76,6 +76,13 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 	}
 
+	if vFrom.IsValid() {
+		if c, ok := findConverter(vTo.Type(), vFrom.Type()); ok && c.flatten != nil {
+			diags.Append(c.flatten(ctx, vFrom, vTo)...)
+			return diags
+		}
+	}
+
 	tTo := valTo.Type(ctx)
 	switch k := vFrom.Kind(); k {
 	case reflect.Bool:
@@ -332,6 +339,15 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
+	case fwtypes.NestedObjectType:
+		if _, ok := findUnion(vFrom.Type()); ok {
+			//
+			// union -> types.List(OfObject) or types.Object.
+			//
+			diags.Append(flattener.structToNestedObject(ctx, vFrom, isNullFrom || vFrom.IsNil(), tTo, vTo)...)
+			return diags
+		}
+
 	case basetypes.StringTypable:
 		stringValue := types.StringNull()
 		if !isNullFrom {
@@ -505,7 +521,17 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			if _, ok := findUnion(tSliceElem); ok {
+				//
+				// []union -> types.List(OfObject).
+				//
+				diags.Append(flattener.sliceOfStructToNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+				return diags
+			}
+		}
+
+		// Other Smithy union types not registered. Silently skip.
 		return diags
 	}
 
@@ -1179,6 +1179,175 @@ func TestFlattenOptions(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenFieldTags(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		ID          types.String `tfsdk:"id" autoflex:"name=VpcId"`
+		Description types.String `tfsdk:"description" autoflex:",omitempty"`
+		Name        types.String `tfsdk:"name" autoflex:"-"`
+		Count       types.Int64  `tfsdk:"count" autoflex:"name=MaxCount,omitempty"`
+	}
+	type aws01 struct {
+		Id          *string
+		VpcId       *string
+		Description *string
+		Name        *string
+		MaxCount    *int64
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "explicit names",
+			Source: &aws01{
+				Id:          aws.String("id-1"),
+				VpcId:       aws.String("vpc-1"),
+				Description: aws.String("test"),
+				Name:        aws.String("ignored"),
+				MaxCount:    aws.Int64(2),
+			},
+			Target: &tf01{},
+			WantTarget: &tf01{
+				ID:          types.StringValue("vpc-1"),
+				Description: types.StringValue("test"),
+				Count:       types.Int64Value(2),
+			},
+		},
+		{
+			TestName: "omit empty",
+			Source: &aws01{
+				VpcId:       aws.String(""),
+				Description: aws.String(""),
+			},
+			Target: &tf01{
+				Description: types.StringValue("test"),
+				Count:       types.Int64Value(2),
+			},
+			WantTarget: &tf01{
+				ID:          types.StringValue(""),
+				Description: types.StringNull(),
+				Count:       types.Int64Null(),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenConverters(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Priority     types.String `tfsdk:"priority"`
+		CreationTime types.Int64  `tfsdk:"creation_time"`
+		DeletionTime types.Int64  `tfsdk:"deletion_time"`
+	}
+	type aws01 struct {
+		Priority     testFlexIntEnum
+		CreationTime *time.Time
+		DeletionTime time.Time
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "custom and epoch converters",
+			Source: &aws01{
+				Priority:     testFlexIntEnumHigh,
+				CreationTime: aws.Time(time.Date(2013, time.September, 25, 9, 34, 1, 0, time.UTC)),
+				DeletionTime: time.Date(2013, time.September, 25, 9, 34, 2, 0, time.UTC),
+			},
+			Target: &tf01{},
+			WantTarget: &tf01{
+				Priority:     types.StringValue("HIGH"),
+				CreationTime: types.Int64Value(1380101641),
+				DeletionTime: types.Int64Value(1380101642),
+			},
+		},
+		{
+			TestName: "zero values",
+			Source:   &aws01{},
+			Target:   &tf01{},
+			WantTarget: &tf01{
+				Priority:     types.StringValue("LOW"),
+				CreationTime: types.Int64Null(),
+				DeletionTime: types.Int64Null(),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "nested object member",
+			Source: &testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberStatic{
+					Value: TestFlexAWS01{Field1: "a"},
+				},
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &testFlexTFUnion{
+					Static: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{
+						Field1: types.StringValue("a"),
+					}),
+					Label: types.StringNull(),
+				}),
+			},
+		},
+		{
+			TestName: "primitive member",
+			Source: &testFlexAWSUnionSingle{
+				Field1: &testFlexAWSUnionMemberName{
+					Value: "b",
+				},
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &testFlexTFUnion{
+					Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Label:  types.StringValue("b"),
+				}),
+			},
+		},
+		{
+			TestName: "nil",
+			Source:   &testFlexAWSUnionSingle{},
+			Target:   &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[testFlexTFUnion](ctx),
+			},
+		},
+		{
+			TestName: "slice",
+			Source: &testFlexAWSUnionSlice{
+				Field1: []testFlexAWSUnion{
+					&testFlexAWSUnionMemberName{Value: "a"},
+					&testFlexAWSUnionMemberName{Value: "b"},
+				},
+			},
+			Target: &testFlexTFUnionListNestedObject{},
+			WantTarget: &testFlexTFUnionListNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []testFlexTFUnion{
+					{
+						Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+						Label:  types.StringValue("a"),
+					},
+					{
+						Static: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+						Label:  types.StringValue("b"),
+					},
+				}),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func runAutoFlattenTestCases(t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -12,6 +12,8 @@ import (
 	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -131,6 +133,11 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if members, ok := findUnion(valTo.Type()); ok && valFrom.Kind() == reflect.Struct {
+			diags.Append(autoFlexExpandUnion(ctx, valFrom, valTo, members, flexer)...)
+			return diags
+		}
+
 		tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -138,6 +145,11 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		return diags
 	}
 
+	if member, ok := findUnionMember(valFrom.Type()); ok && valTo.Kind() == reflect.Struct {
+		diags.Append(autoFlexFlattenUnion(ctx, valFrom, valTo, member, flexer)...)
+		return diags
+	}
+
 	opts := flexer.getOptions()
 	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
 		field := typFrom.Field(i)
@@ -151,15 +163,32 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		if fieldName == MapBlockKey {
 			continue
 		}
+		fromFieldOpts := autoFlexFieldOptions(field)
+		if fromFieldOpts.ignore {
+			continue
+		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom, flexer)
-		if !toFieldVal.IsValid() {
+		toField, ok := findField(ctx, field, valTo, valFrom, flexer)
+		if !ok {
 			continue // Corresponding field not found in to.
 		}
+		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
 			continue // Corresponding field value can't be changed.
 		}
 
+		if fromFieldOpts.omitempty || autoFlexFieldOptions(toField).omitempty {
+			isEmpty, d := autoFlexConvertEmpty(ctx, valFrom.Field(i), toFieldVal)
+			diags.Append(d...)
+			if diags.HasError() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
+				return diags
+			}
+			if isEmpty {
+				continue
+			}
+		}
+
 		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
 		if diags.HasError() {
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
@@ -170,6 +199,105 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	return diags
 }
 
+// autoFlexConvertEmpty handles a field pair for which the "omitempty" option is set.
+// An empty Plugin Framework value is not expanded and an empty AWS API value is flattened to null.
+// Returns whether the source value is empty.
+func autoFlexConvertEmpty(ctx context.Context, vFrom, vTo reflect.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Expand.
+	if v, ok := vFrom.Interface().(attr.Value); ok {
+		return isEmptyAttrValue(ctx, v), diags
+	}
+
+	// Flatten.
+	if !isEmptyReflectValue(vFrom) {
+		return false, diags
+	}
+
+	diags.Append(setNull(ctx, vTo)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return true, diags
+}
+
+// setNull sets `vTo`, a Plugin Framework value, to null.
+func setNull(ctx context.Context, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+		return diags
+	}
+
+	tTo := valTo.Type(ctx)
+	v, err := tTo.ValueFromTerraform(ctx, tftypes.NewValue(tTo.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddError("AutoFlEx", err.Error())
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(v))
+
+	return diags
+}
+
+// isEmptyAttrValue returns whether the specified Plugin Framework value is null, unknown or the zero value of its type.
+func isEmptyAttrValue(ctx context.Context, v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return true
+	}
+
+	switch v := v.(type) {
+	case basetypes.BoolValuable:
+		boolValue, _ := v.ToBoolValue(ctx)
+		return !boolValue.ValueBool()
+
+	case basetypes.Float64Valuable:
+		float64Value, _ := v.ToFloat64Value(ctx)
+		return float64Value.ValueFloat64() == 0
+
+	case basetypes.Int64Valuable:
+		int64Value, _ := v.ToInt64Value(ctx)
+		return int64Value.ValueInt64() == 0
+
+	case basetypes.StringValuable:
+		stringValue, _ := v.ToStringValue(ctx)
+		return stringValue.ValueString() == ""
+
+	case basetypes.ListValuable:
+		listValue, _ := v.ToListValue(ctx)
+		return len(listValue.Elements()) == 0
+
+	case basetypes.MapValuable:
+		mapValue, _ := v.ToMapValue(ctx)
+		return len(mapValue.Elements()) == 0
+
+	case basetypes.SetValuable:
+		setValue, _ := v.ToSetValue(ctx)
+		return len(setValue.Elements()) == 0
+	}
+
+	return false
+}
+
+// isEmptyReflectValue returns whether the specified AWS API value is nil, the zero value of its type
+// or a pointer to the zero value of its type.
+func isEmptyReflectValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil() || isEmptyReflectValue(v.Elem())
+
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
+
 func fullTypeName(t reflect.Type) string {
 	if t.Kind() == reflect.Pointer {
 		return "*" + fullTypeName(t.Elem())
@@ -180,10 +308,95 @@ func fullTypeName(t reflect.Type) string {
 	return t.Name()
 }
 
-func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, flexer autoFlexer) reflect.Value {
+// autoFlexTagKey is the key of the struct tag used to customize how a field is converted.
+//
+// The tag value is a comma-separated list of options:
+//
+//   - `name=<Name>` maps the field explicitly to the named field in the corresponding data structure,
+//     bypassing fuzzy name matching in both directions
+//   - `omitempty` expands an empty value to no value and flattens an empty value to null
+//
+// A tag value of `-` causes the field to be ignored in both directions.
+const autoFlexTagKey = "autoflex"
+
+// autoFlexFieldOpts stores the options set for a field in its `autoflex` struct tag.
+type autoFlexFieldOpts struct {
+	ignore    bool
+	name      string
+	omitempty bool
+}
+
+// autoFlexFieldOptions parses the `autoflex` struct tag of the specified field.
+func autoFlexFieldOptions(field reflect.StructField) autoFlexFieldOpts {
+	var opts autoFlexFieldOpts
+
+	tag, ok := field.Tag.Lookup(autoFlexTagKey)
+	if !ok {
+		return opts
+	}
+
+	if tag == "-" {
+		opts.ignore = true
+		return opts
+	}
+
+	for _, v := range strings.Split(tag, ",") {
+		switch v = strings.TrimSpace(v); {
+		case strings.HasPrefix(v, "name="):
+			opts.name = strings.TrimPrefix(v, "name=")
+		case v == "omitempty":
+			opts.omitempty = true
+		}
+	}
+
+	return opts
+}
+
+// findField returns the field of struct `valTo` corresponding to field `fieldFrom` of struct `valFrom`.
+// Explicit mappings set in `autoflex` struct tags take precedence over fuzzy name matching.
+func findField(ctx context.Context, fieldFrom reflect.StructField, valTo, valFrom reflect.Value, flexer autoFlexer) (reflect.StructField, bool) {
+	typTo := valTo.Type()
+
+	if name := autoFlexFieldOptions(fieldFrom).name; name != "" {
+		field, ok := typTo.FieldByName(name)
+		if !ok || field.PkgPath != "" || autoFlexFieldOptions(field).ignore {
+			return reflect.StructField{}, false
+		}
+		return field, true
+	}
+
+	for i := 0; i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if autoFlexFieldOptions(field).name == fieldFrom.Name {
+			return field, true
+		}
+	}
+
+	return findFieldFuzzy(ctx, fieldFrom.Name, typTo, valFrom, flexer)
+}
+
+// fieldByName returns the field of struct type `typ` with the specified name.
+// Fields that are ignored or explicitly mapped via their `autoflex` struct tag are not returned.
+func fieldByName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return reflect.StructField{}, false
+	}
+
+	if opts := autoFlexFieldOptions(field); opts.ignore || opts.name != "" {
+		return reflect.StructField{}, false
+	}
+
+	return field, true
+}
+
+func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typTo reflect.Type, valFrom reflect.Value, flexer autoFlexer) (reflect.StructField, bool) {
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
-		return v
+	if field, ok := fieldByName(typTo, fieldNameFrom); ok {
+		return field, true
 	}
 
 	// If a "from" field fuzzy matches a "to" field, we are certain the fuzzy match
@@ -194,7 +407,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 
 	// second precedence is exact match (case insensitive)
 	opts := flexer.getOptions()
-	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+	for i := 0; i < typTo.NumField(); i++ {
 		field := typTo.Field(i)
 		if field.PkgPath != "" {
 			continue // Skip unexported fields.
@@ -203,22 +416,21 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		if opts.IsIgnoredField(fieldNameTo) {
 			continue
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
-			// probably could assume validity here since reflect gave the field name
-			return v
+		if field, ok := fieldByName(typTo, fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+			return field, true
 		}
 	}
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
-			return v
+		if field, ok := fieldByName(typTo, plural.Plural(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
-			return v
+		if field, ok := fieldByName(typTo, plural.Singular(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
@@ -229,14 +441,14 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 			// so it will only recurse once
 			ctx = context.WithValue(ctx, ResourcePrefixRecurse, true)
 			if strings.HasPrefix(fieldNameFrom, v) {
-				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom, flexer)
+				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), typTo, valFrom, flexer)
 			}
-			return findFieldFuzzy(ctx, v+fieldNameFrom, valTo, valFrom, flexer)
+			return findFieldFuzzy(ctx, v+fieldNameFrom, typTo, valFrom, flexer)
 		}
 	}
 
-	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	// no finds, fuzzy or otherwise
+	return reflect.StructField{}, false
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// converterKey identifies a custom converter by its Plugin Framework and AWS API types.
+type converterKey struct {
+	tfType  reflect.Type
+	apiType reflect.Type
+}
+
+// converter stores the reflection-based conversion functions of a custom converter.
+type converter struct {
+	expand  func(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	flatten func(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+}
+
+var (
+	// converters is the registry of custom converters, keyed by converterKey.
+	converters sync.Map
+)
+
+// RegisterConverter registers functions converting between Plugin Framework type T and AWS API type U.
+// Expand and Flatten use the functions for any value of type T whose counterpart is of type U,
+// in preference to the built-in conversions.
+// This allows AutoFlex to handle AWS API shapes, such as SDK enums, unions or epoch timestamps,
+// that have no natural Plugin Framework equivalent.
+//
+// `expand` is not called for null or unknown values. `flatten` must handle nil values of U.
+// Either function may be nil if conversion is only required in one direction.
+// Converters are typically registered from an init function.
+func RegisterConverter[T attr.Value, U any](expand func(context.Context, T) (U, diag.Diagnostics), flatten func(context.Context, U) (T, diag.Diagnostics)) {
+	var c converter
+
+	if expand != nil {
+		c.expand = func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			v, d := expand(ctx, vFrom.Interface().(T))
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(&v).Elem())
+
+			return diags
+		}
+	}
+
+	if flatten != nil {
+		c.flatten = func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			var from U
+			if v := vFrom.Interface(); v != nil {
+				from = v.(U)
+			}
+
+			v, d := flatten(ctx, from)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(&v).Elem())
+
+			return diags
+		}
+	}
+
+	converters.Store(converterKey{tfType: reflect.TypeFor[T](), apiType: reflect.TypeFor[U]()}, c)
+}
+
+// findConverter returns any custom converter registered for the specified Plugin Framework and AWS API types.
+func findConverter(tfType, apiType reflect.Type) (converter, bool) {
+	v, ok := converters.Load(converterKey{tfType: tfType, apiType: apiType})
+	if !ok {
+		return converter{}, false
+	}
+
+	return v.(converter), true
+}
+
+func init() {
+	// Epoch timestamps, in seconds.
+	RegisterConverter(expandEpochSeconds, flattenEpochSeconds)
+	RegisterConverter(expandEpochSecondsValue, flattenEpochSecondsValue)
+}
+
+func expandEpochSeconds(_ context.Context, v types.Int64) (*time.Time, diag.Diagnostics) {
+	return aws.Time(time.Unix(v.ValueInt64(), 0).UTC()), nil
+}
+
+func flattenEpochSeconds(_ context.Context, v *time.Time) (types.Int64, diag.Diagnostics) {
+	if v == nil {
+		return types.Int64Null(), nil
+	}
+
+	return types.Int64Value(v.Unix()), nil
+}
+
+func expandEpochSecondsValue(_ context.Context, v types.Int64) (time.Time, diag.Diagnostics) {
+	return time.Unix(v.ValueInt64(), 0).UTC(), nil
+}
+
+func flattenEpochSecondsValue(_ context.Context, v time.Time) (types.Int64, diag.Diagnostics) {
+	if v.IsZero() {
+		return types.Int64Null(), nil
+	}
+
+	return types.Int64Value(v.Unix()), nil
+}
@@ -428,6 +428,43 @@ var _ testFlexAWSInterfaceInterface = &testFlexAWSInterfaceInterfaceImpl{}
 
 func (t *testFlexAWSInterfaceInterfaceImpl) isTestFlexAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type testFlexTFUnionListNestedObject struct {
+	Field1 fwtypes.ListNestedObjectValueOf[testFlexTFUnion] `tfsdk:"field1"`
+}
+
+type testFlexTFUnion struct {
+	Static fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"static"`
+	Label  types.String                                  `tfsdk:"label" autoflex:"name=Name"`
+}
+
+type testFlexAWSUnionSingle struct {
+	Field1 testFlexAWSUnion
+}
+
+type testFlexAWSUnionSlice struct {
+	Field1 []testFlexAWSUnion
+}
+
+type testFlexAWSUnion interface {
+	isTestFlexAWSUnion()
+}
+
+type testFlexAWSUnionMemberStatic struct {
+	Value TestFlexAWS01
+}
+
+func (*testFlexAWSUnionMemberStatic) isTestFlexAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type testFlexAWSUnionMemberName struct {
+	Value string
+}
+
+func (*testFlexAWSUnionMemberName) isTestFlexAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+func init() {
+	RegisterUnion[testFlexAWSUnion](&testFlexAWSUnionMemberStatic{}, &testFlexAWSUnionMemberName{})
+}
+
 type testFlexTFExpander struct {
 	Field1 types.String `tfsdk:"field1"`
 }
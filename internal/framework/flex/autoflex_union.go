@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// AWS SDK for Go v2 models a union as an interface that is implemented by one `<Union>Member<Name>`
+// struct per member, each with a single `Value` field.
+// A union corresponds to a Plugin Framework nested object with one attribute or block per member,
+// of which at most one may be set.
+
+// unionMember describes a member of an AWS API union.
+type unionMember struct {
+	name string
+	typ  reflect.Type // The member's struct type.
+}
+
+var (
+	// unions is the registry of union members, keyed by union interface type.
+	unions sync.Map
+	// unionMembers is the registry of union members, keyed by member struct type.
+	unionMembers sync.Map
+)
+
+// RegisterUnion registers the members of AWS API union type T, for example
+//
+//	flex.RegisterUnion[awstypes.PolicyDefinition](&awstypes.PolicyDefinitionMemberStatic{}, &awstypes.PolicyDefinitionMemberTemplateLinked{})
+//
+// The name of each member is its type name without the `<Union>Member` prefix.
+// Expand and Flatten map the member to the field of the nested object with the same name,
+// or to the field explicitly mapped to the name with an `autoflex` struct tag.
+// Unions are typically registered from an init function.
+func RegisterUnion[T any](members ...T) {
+	tUnion := reflect.TypeFor[T]()
+	if tUnion.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("union type %s is not an interface", fullTypeName(tUnion)))
+	}
+
+	var v []unionMember
+	for _, member := range members {
+		tMember := reflect.TypeOf(member)
+		if tMember.Kind() != reflect.Ptr || tMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("union member type %s is not a struct pointer", fullTypeName(tMember)))
+		}
+		tMember = tMember.Elem()
+
+		if _, ok := tMember.FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("union member type %s has no %s field", fullTypeName(tMember), unionMemberValueFieldName))
+		}
+
+		member := unionMember{
+			name: strings.TrimPrefix(tMember.Name(), tUnion.Name()+"Member"),
+			typ:  tMember,
+		}
+		v = append(v, member)
+		unionMembers.Store(tMember, member)
+	}
+
+	unions.Store(tUnion, v)
+}
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+// findUnion returns the members registered for the specified union type.
+func findUnion(tUnion reflect.Type) ([]unionMember, bool) {
+	v, ok := unions.Load(tUnion)
+	if !ok {
+		return nil, false
+	}
+
+	return v.([]unionMember), true
+}
+
+// findUnionMember returns the union member registered for the specified struct type.
+func findUnionMember(tMember reflect.Type) (unionMember, bool) {
+	v, ok := unionMembers.Load(tMember)
+	if !ok {
+		return unionMember{}, false
+	}
+
+	return v.(unionMember), true
+}
+
+// unionFields returns the exported, non-ignored fields of struct type `typ`, which represent the members of a union.
+func unionFields(typ reflect.Type, opts AutoFlexOptions) []reflect.StructField {
+	var fields []reflect.StructField
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if opts.IsIgnoredField(field.Name) {
+			continue
+		}
+		if autoFlexFieldOptions(field).ignore {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// unionFieldMatchesMember returns whether the specified field corresponds to the named union member.
+func unionFieldMatchesMember(field reflect.StructField, name string) bool {
+	if v := autoFlexFieldOptions(field).name; v != "" {
+		return v == name
+	}
+
+	return strings.EqualFold(field.Name, name)
+}
+
+// autoFlexExpandUnion expands struct `valFrom`, a Plugin Framework nested object with one field per union member,
+// to `valTo`, an AWS API union.
+func autoFlexExpandUnion(ctx context.Context, valFrom, valTo reflect.Value, members []unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := unionFields(valFrom.Type(), flexer.getOptions())
+
+	var set []reflect.StructField
+	for _, field := range fields {
+		v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value)
+		if !ok {
+			diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", field.Type.Kind()))
+			return diags
+		}
+
+		if !isUnionMemberSet(ctx, v) {
+			continue
+		}
+
+		set = append(set, field)
+	}
+
+	if len(set) == 0 {
+		return diags
+	}
+
+	if len(set) > 1 {
+		diags.Append(diagMultipleUnionMembers(fields, set))
+		return diags
+	}
+
+	field := set[0]
+	for _, member := range members {
+		if !unionFieldMatchesMember(field, member.name) {
+			continue
+		}
+
+		to := reflect.New(member.typ)
+		diags.Append(flexer.convert(ctx, valFrom.FieldByIndex(field.Index), to.Elem().FieldByName(unionMemberValueFieldName))...)
+		if diags.HasError() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", field.Name))
+			return diags
+		}
+
+		valTo.Set(to)
+
+		return diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("no member of union %s corresponds to field %s", fullTypeName(valTo.Type()), field.Name))
+
+	return diags
+}
+
+// autoFlexFlattenUnion flattens struct `valFrom`, an AWS API union member,
+// to `valTo`, a Plugin Framework nested object with one field per union member.
+// The fields corresponding to the other members are set to null.
+func autoFlexFlattenUnion(ctx context.Context, valFrom, valTo reflect.Value, member unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var found bool
+	for _, field := range unionFields(valTo.Type(), flexer.getOptions()) {
+		toFieldVal := valTo.FieldByIndex(field.Index)
+
+		if !found && unionFieldMatchesMember(field, member.name) {
+			found = true
+
+			diags.Append(flexer.convert(ctx, valFrom.FieldByName(unionMemberValueFieldName), toFieldVal)...)
+			if diags.HasError() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", field.Name))
+				return diags
+			}
+
+			continue
+		}
+
+		diags.Append(setNull(ctx, toFieldVal)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !found {
+		diags.AddError("AutoFlEx", fmt.Sprintf("no field of %s corresponds to union member %s", fullTypeName(valTo.Type()), member.name))
+	}
+
+	return diags
+}
+
+// isUnionMemberSet returns whether the specified Plugin Framework value represents a configured union member.
+// Null and unknown values and empty collections, such as omitted blocks, are not configured.
+func isUnionMemberSet(ctx context.Context, v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if _, ok := v.(valueWithElementsAs); ok {
+		return !isEmptyAttrValue(ctx, v)
+	}
+
+	return true
+}
+
+func diagMultipleUnionMembers(fields, set []reflect.StructField) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("At most one of %s can be configured, got: %s.", unionFieldNames(fields), unionFieldNames(set)),
+	)
+}
+
+// unionFieldNames returns the attribute names of the specified fields, for use in diagnostics.
+func unionFieldNames(fields []reflect.StructField) string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ",")
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -7,15 +7,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 )
 
 var (
@@ -119,7 +119,7 @@ func (v IAMPolicy) Type(context.Context) attr.Type {
 	return IAMPolicyType
 }
 
-func (v IAMPolicy) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+func (v IAMPolicy) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	newValue, ok := newValuable.(IAMPolicy)
@@ -128,33 +128,14 @@ func (v IAMPolicy) StringSemanticEquals(_ context.Context, newValuable basetypes
 		return false, diags
 	}
 
-	return policyStringsEquivalent(v.ValueString(), newValue.ValueString()), diags
-}
-
-// See verify.PolicyStringsEquivalent, which can't be called because of import cycles.
-func policyStringsEquivalent(s1, s2 string) bool {
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
-		return true
+	if !iampolicy.StringsEquivalent(v.ValueString(), newValue.ValueString()) {
+		return false, diags
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
-	if err != nil {
-		return false
-	}
+	// The receiver is the proposed new value and the argument is the prior value.
+	logging.LogSuppressedDiffContext(ctx, "equivalent IAM policies", newValue.ValueString(), v.ValueString(), iampolicy.Canonicalize)
 
-	return equivalent
+	return true, diags
 }
 
 func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
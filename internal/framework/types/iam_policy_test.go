@@ -142,6 +142,43 @@ func TestIAMPolicyStringSemanticEquals(t *testing.T) {
     ]
   }]
 }
+`),
+			equals: true,
+		},
+		"equals wildcard principal": {
+			val1: fwtypes.IAMPolicyValue(`
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:example",
+    "Condition": {
+      "ArnEquals": {
+        "aws:SourceArn": ["arn:aws:sns:us-west-2:123456789012:example"]
+      }
+    }
+  }
+}
+`),
+			val2: fwtypes.IAMPolicyValue(`
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "AWS": "*"
+    },
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:example",
+    "Condition": {
+      "ArnEquals": {
+        "aws:SourceArn": "arn:aws:sns:us-west-2:123456789012:example"
+      }
+    }
+  }]
+}
 `),
 			equals: true,
 		},
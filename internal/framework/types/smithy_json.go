@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 )
 
 var (
@@ -189,6 +190,11 @@ func (v SmithyJSON[T]) StringSemanticEquals(ctx context.Context, newValuable bas
 		return false, diags
 	}
 
+	if result {
+		// The receiver is the proposed new value and the argument is the prior value.
+		logging.LogSuppressedDiffContext(ctx, "equivalent JSON", newValue.ValueString(), v.ValueString(), smithyjson.Normalize)
+	}
+
 	return result, diags
 }
 
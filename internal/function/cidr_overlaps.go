@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_overlaps Function",
+		MarkdownDescription: "Returns whether any two of the specified CIDR blocks overlap. This function can be " +
+			"used to detect overlapping VPC and subnet address ranges at plan time.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidr_blocks",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IPv4 or IPv6 CIDR blocks to compare",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrBlocksOverlap(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrBlocksOverlap returns whether any pair of the specified CIDR blocks overlap
+func cidrBlocksOverlap(cidrs []string) (bool, error) {
+	for _, cidr := range cidrs {
+		if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+			return false, err
+		}
+	}
+
+	for i := 0; i < len(cidrs); i++ {
+		for j := i + 1; j < len(cidrs); j++ {
+			overlap, err := itypes.CIDRBlocksOverlap(cidrs[i], cidrs[j])
+			if err != nil {
+				return false, err
+			}
+
+			if overlap {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrSubnetsForAZsFunction{}
+
+func NewCIDRSubnetsForAZsFunction() function.Function {
+	return &cidrSubnetsForAZsFunction{}
+}
+
+type cidrSubnetsForAZsFunction struct{}
+
+func (f cidrSubnetsForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_azs"
+}
+
+func (f cidrSubnetsForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_for_azs Function",
+		MarkdownDescription: "Carves equally sized, consecutive subnets out of a CIDR block, one for each " +
+			"Availability Zone, and returns a map of Availability Zone to subnet CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to divide, typically the VPC CIDR block",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zone names or IDs, in the order in which subnets are allocated",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits with which to extend the prefix of `cidr_block`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var azs []string
+	var newbits int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &azs, &newbits))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrSubnetsForAZs(cidr, azs, int(newbits))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrSubnetsForAZs allocates the n-th subnet of the extended prefix to the n-th Availability Zone
+func cidrSubnetsForAZs(cidr string, azs []string, newbits int) (map[string]string, error) {
+	if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+		return nil, err
+	}
+
+	if newbits < 0 {
+		return nil, fmt.Errorf("newbits (%d) must not be negative", newbits)
+	}
+
+	if newbits < 63 && len(azs) > 1<<newbits {
+		return nil, fmt.Errorf("prefix extension of %d accommodates at most %d subnets, %d Availability Zones specified", newbits, 1<<newbits, len(azs))
+	}
+
+	result := make(map[string]string, len(azs))
+
+	for i, az := range azs {
+		if _, ok := result[az]; ok {
+			return nil, fmt.Errorf("duplicate Availability Zone (%s)", az)
+		}
+
+		subnet, err := itypes.CIDRSubnet(cidr, newbits, i)
+		if err != nil {
+			return nil, err
+		}
+
+		result[az] = subnet
+	}
+
+	return result, nil
+}
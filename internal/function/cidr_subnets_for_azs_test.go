@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsForAZsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("us-west-2a", "10.0.0.0/18"),
+					resource.TestCheckOutput("us-west-2b", "10.0.64.0/18"),
+					resource.TestCheckOutput("us-west-2c", "10.0.128.0/18"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("2001:db8::/56", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("us-west-2a", "2001:db8::/64"),
+					resource.TestCheckOutput("us-west-2b", "2001:db8:0:1::/64"),
+					resource.TestCheckOutput("us-west-2c", "2001:db8:0:2::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_insufficientNewbits(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 1),
+				ExpectError: regexache.MustCompile(`accommodates[\s\n]*at[\s\n]*most[\s\n]*2[\s\n]*subnets`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsForAZsFunctionConfig(cidr, azs string, newbits int) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_for_azs(%[1]q, %[2]s, %[3]d)
+}
+
+output "us-west-2a" {
+  value = local.subnets["us-west-2a"]
+}
+
+output "us-west-2b" {
+  value = local.subnets["us-west-2b"]
+}
+
+output "us-west-2c" {
+  value = local.subnets["us-west-2c"]
+}
+`, cidr, azs, newbits)
+}
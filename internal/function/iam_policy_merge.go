@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges one or more IAM policy JSON documents into a single normalized document. " +
+			"Statements are merged in argument order; a statement with a non-empty `Sid` replaces any " +
+			"earlier statement with the same `Sid`.",
+		VariadicParameter: function.StringParameter{
+			Name:                "policies",
+			MarkdownDescription: "IAM policy JSON documents to merge",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(args) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("at least one policy document must be specified"))
+		return
+	}
+
+	mergedDoc := &tfiam.IAMPolicyDoc{}
+
+	for i, arg := range args {
+		doc, err := unmarshalPolicy(arg)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+			return
+		}
+
+		mergedDoc.Merge(doc)
+	}
+
+	result, err := marshalPolicy(mergedDoc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// unmarshalPolicy decodes an IAM policy JSON document
+func unmarshalPolicy(s string) (*tfiam.IAMPolicyDoc, error) {
+	doc := &tfiam.IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(s), doc); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	return doc, nil
+}
+
+// marshalPolicy encodes an IAM policy document as minified JSON
+func marshalPolicy(doc *tfiam.IAMPolicyDoc) (string, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("encoding JSON: %w", err)
+	}
+
+	return string(b), nil
+}
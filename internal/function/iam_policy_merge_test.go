@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_overrideSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_overrideSid(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_singleStatement(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_singleStatement(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_numericCondition(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_numericCondition(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"iam:*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":"3600"}}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`decoding[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig_basic() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge(
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "A"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }]
+    }),
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "B"
+        Effect   = "Allow"
+        Action   = "s3:PutObject"
+        Resource = "*"
+      }]
+    }),
+  )
+}`
+}
+
+func testIAMPolicyMergeFunctionConfig_overrideSid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge(
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "A"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }]
+    }),
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "A"
+        Effect   = "Deny"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }]
+    }),
+  )
+}`
+}
+
+func testIAMPolicyMergeFunctionConfig_singleStatement() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge(
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = {
+        Sid      = "A"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }
+    }),
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "B"
+        Effect   = "Allow"
+        Action   = "s3:PutObject"
+        Resource = "*"
+      }]
+    }),
+  )
+}`
+}
+
+func testIAMPolicyMergeFunctionConfig_numericCondition() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge(
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "A"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }]
+    }),
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "B"
+        Effect   = "Allow"
+        Action   = "iam:*"
+        Resource = "*"
+        Condition = {
+          NumericLessThan = {
+            "aws:MultiFactorAuthAge" = 3600
+          }
+        }
+      }]
+    }),
+  )
+}`
+}
+
+func testIAMPolicyMergeFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge(jsonencode({ Version = "2012-10-17" }), "invalid")
+}`
+}
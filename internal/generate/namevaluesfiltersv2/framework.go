@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfiltersv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// FilterModel is the Terraform Plugin Framework model of a single filter described by SchemaBlock().
+type FilterModel struct {
+	Name   types.String                     `tfsdk:"name"`
+	Values fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
+
+// SchemaBlock returns a schema.Block that represents a set of custom filtering criteria
+// that a user can specify as input to a Terraform Plugin Framework data source.
+// It is conventional for a block of this type to be included as a top-level block called "filter".
+func SchemaBlock(ctx context.Context) schema.Block {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[FilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+				"values": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func (filters NameValuesFilters) addFramework(value []*FilterModel) NameValuesFilters {
+	for _, filter := range value {
+		if filter == nil || filter.Name.IsNull() || filter.Name.IsUnknown() {
+			continue
+		}
+
+		name := filter.Name.ValueString()
+
+		for _, v := range filter.Values.Elements() {
+			v, ok := v.(types.String)
+			if !ok || v.IsNull() || v.IsUnknown() {
+				continue
+			}
+
+			filters[name] = append(filters[name], v.ValueString())
+		}
+	}
+
+	return filters
+}
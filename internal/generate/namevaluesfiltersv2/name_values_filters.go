@@ -14,7 +14,7 @@ import (
 type NameValuesFilters map[string][]string
 
 // Add adds missing and updates existing filters from common Terraform Provider SDK types.
-// Supports map[string]string, map[string][]string, *schema.Set.
+// Supports map[string]string, map[string][]string, *schema.Set, []*FilterModel.
 func (filters NameValuesFilters) Add(i interface{}) NameValuesFilters {
 	switch value := i.(type) {
 	case map[string]string:
@@ -57,6 +57,10 @@ func (filters NameValuesFilters) Add(i interface{}) NameValuesFilters {
 				}
 			}
 		}
+
+	case []*FilterModel:
+		// The set of filters described by SchemaBlock().
+		return filters.addFramework(value)
 	}
 
 	return filters
@@ -96,7 +100,7 @@ func (filters NameValuesFilters) Map() map[string][]string {
 }
 
 // New creates NameValuesFilters from common Terraform Provider SDK types.
-// Supports map[string]string, map[string][]string, *schema.Set.
+// Supports map[string]string, map[string][]string, *schema.Set, []*FilterModel.
 func New(i interface{}) NameValuesFilters {
 	return make(NameValuesFilters).Add(i)
 }
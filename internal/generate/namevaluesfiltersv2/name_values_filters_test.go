@@ -4,12 +4,17 @@
 package namevaluesfiltersv2_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
 )
 
 func TestNameValuesFiltersMap(t *testing.T) {
@@ -182,3 +187,31 @@ func testNameValuesFiltersHashSet(v interface{}) int {
 	m := v.(map[string]interface{})
 	return create.StringHashcode(m["name"].(string))
 }
+
+func TestNameValuesFiltersAddFramework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got := namevaluesfiltersv2.New(map[string][]string{
+		"name1": {"value1a"},
+	}).Add([]*namevaluesfiltersv2.FilterModel{
+		{
+			Name:   types.StringValue("name1"),
+			Values: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value1b")}),
+		},
+		{
+			Name:   types.StringValue("name2"),
+			Values: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value2a"), types.StringValue("value2b")}),
+		},
+		{
+			Name:   types.StringNull(),
+			Values: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value3")}),
+		},
+		nil,
+	})
+
+	testNameValuesFiltersVerifyMap(t, got.Map(), map[string][]string{
+		"name1": {"value1a", "value1b"},
+		"name2": {"value2a", "value2b"},
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+)
+
+const (
+	elementAction       = "Action"
+	elementCondition    = "Condition"
+	elementNotAction    = "NotAction"
+	elementNotPrincipal = "NotPrincipal"
+	elementNotResource  = "NotResource"
+	elementPrincipal    = "Principal"
+	elementResource     = "Resource"
+	elementStatement    = "Statement"
+
+	principalTypeAWS = "AWS"
+	wildcard         = "*"
+)
+
+var (
+	accountRootARNRegex = regexache.MustCompile(`^arn:[^:]+:iam::([0-9]{12}):root$`)
+)
+
+// Canonicalize returns the canonical form of an IAM policy document.
+// Equivalent forms of the same policy have the same canonical form:
+//
+//   - A Principal or NotPrincipal of "*" is the same as {"AWS": "*"}
+//   - An AWS account root user ARN principal is the same as the account ID
+//   - Single-element lists are the same as their element, and list order and duplicates are ignored
+//   - Boolean and numeric values are the same as their string representations
+//
+// The unique ID that AWS substitutes for the ARN of a deleted IAM role or user principal is not the same as the ARN.
+// The policy no longer applies to a principal recreated with the same ARN, so that difference must be planned.
+//
+// Statement order is not canonicalized. Use Equivalent to compare policies.
+// An empty policy is returned unchanged.
+func Canonicalize(policy string) (string, error) {
+	if strings.TrimSpace(policy) == "" {
+		return policy, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return "", fmt.Errorf("decoding policy: %w", err)
+	}
+
+	// Some policies, such as assume role policies, may be a list of documents.
+	switch v := v.(type) {
+	case map[string]any:
+		canonicalizeDocument(v)
+	case []any:
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				canonicalizeDocument(v)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return "", fmt.Errorf("encoding policy: %w", err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Equivalent returns whether two IAM policy documents are equivalent.
+// Policies are compared in canonical form, ignoring statement order and whitespace.
+func Equivalent(policy1, policy2 string) (bool, error) {
+	policy1, err := Canonicalize(policy1)
+	if err != nil {
+		return false, fmt.Errorf("policy 1: %w", err)
+	}
+
+	policy2, err = Canonicalize(policy2)
+	if err != nil {
+		return false, fmt.Errorf("policy 2: %w", err)
+	}
+
+	return awspolicy.PoliciesAreEquivalent(policy1, policy2)
+}
+
+// StringsEquivalent returns whether two IAM policy documents are equivalent.
+// Empty strings and empty JSON objects are equivalent, and invalid policies are not equivalent to anything.
+func StringsEquivalent(s1, s2 string) bool {
+	if isEmpty(s1) && isEmpty(s2) {
+		return true
+	}
+
+	equivalent, err := Equivalent(s1, s2)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+func isEmpty(s string) bool {
+	s = strings.TrimSpace(s)
+	return s == "" || s == "{}"
+}
+
+func canonicalizeDocument(document map[string]any) {
+	switch v := document[elementStatement].(type) {
+	case map[string]any:
+		canonicalizeStatement(v)
+	case []any:
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				canonicalizeStatement(v)
+			}
+		}
+	}
+}
+
+func canonicalizeStatement(statement map[string]any) {
+	for _, k := range []string{elementAction, elementNotAction, elementResource, elementNotResource} {
+		if v, ok := statement[k]; ok {
+			statement[k] = canonicalValues(v, nil)
+		}
+	}
+
+	for _, k := range []string{elementPrincipal, elementNotPrincipal} {
+		if v, ok := statement[k]; ok {
+			statement[k] = canonicalPrincipal(v)
+		}
+	}
+
+	if v, ok := statement[elementCondition].(map[string]any); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				for k, values := range v {
+					v[k] = canonicalValues(values, nil)
+				}
+			}
+		}
+	}
+}
+
+func canonicalPrincipal(v any) any {
+	switch v := v.(type) {
+	case string:
+		if v == wildcard {
+			return map[string]any{principalTypeAWS: wildcard}
+		}
+	case map[string]any:
+		for k, values := range v {
+			var f func(string) string
+			if k == principalTypeAWS {
+				f = canonicalAWSPrincipal
+			}
+			v[k] = canonicalValues(values, f)
+		}
+	}
+
+	return v
+}
+
+// canonicalAWSPrincipal returns the account ID of an AWS account root user ARN principal.
+// AWS returns an account ID principal as the account's root user ARN.
+func canonicalAWSPrincipal(s string) string {
+	if m := accountRootARNRegex.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+
+	return s
+}
+
+// canonicalValues returns the canonical form of a value that is either a scalar or a list of scalars.
+// Values are converted to strings and optionally transformed, and lists are sorted with duplicates removed.
+// A single-element list is returned as its element. Other values are returned unchanged.
+func canonicalValues(v any, f func(string) string) any {
+	var values []string
+
+	switch tv := v.(type) {
+	case []any:
+		if len(tv) == 0 {
+			return v
+		}
+		for _, e := range tv {
+			s, ok := scalarString(e)
+			if !ok {
+				return v
+			}
+			values = append(values, s)
+		}
+	default:
+		s, ok := scalarString(v)
+		if !ok {
+			return v
+		}
+		values = append(values, s)
+	}
+
+	if f != nil {
+		for i, s := range values {
+			values[i] = f(s)
+		}
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
+
+func scalarString(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case json.Number:
+		return v.String(), true
+	default:
+		return "", false
+	}
+}
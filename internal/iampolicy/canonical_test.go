@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy        string
+		expected      string
+		expectedError bool
+	}{
+		"empty": {
+			policy:   " ",
+			expected: " ",
+		},
+		"invalid JSON": {
+			policy:        `{"Statement":`,
+			expectedError: true,
+		},
+		"wildcard principal": {
+			policy:   `{"Statement":{"Effect":"Allow","Principal":"*","Action":"s3:GetObject"}}`,
+			expected: `{"Statement":{"Action":"s3:GetObject","Effect":"Allow","Principal":{"AWS":"*"}}}`,
+		},
+		"wildcard not principal": {
+			policy:   `{"Statement":[{"Effect":"Deny","NotPrincipal":"*"}]}`,
+			expected: `{"Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"*"}}]}`,
+		},
+		"account root principals": {
+			policy:   `{"Statement":[{"Principal":{"AWS":["arn:aws:iam::123456789012:root","123456789012","arn:aws:iam::123456789012:role/example"],"Service":["sqs.amazonaws.com"]}}]}`,
+			expected: `{"Statement":[{"Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:role/example"],"Service":"sqs.amazonaws.com"}}]}`,
+		},
+		"role unique ID principal": {
+			policy:   `{"Statement":[{"Principal":{"AWS":"AROAEXAMPLEUNIQUEID12"}}]}`,
+			expected: `{"Statement":[{"Principal":{"AWS":"AROAEXAMPLEUNIQUEID12"}}]}`,
+		},
+		"actions and resources": {
+			policy:   `{"Statement":[{"Action":["s3:PutObject","s3:GetObject","s3:GetObject"],"Resource":["arn:aws:s3:::example/*"],"NotAction":[]}]}`,
+			expected: `{"Statement":[{"Action":["s3:GetObject","s3:PutObject"],"NotAction":[],"Resource":"arn:aws:s3:::example/*"}]}`,
+		},
+		"conditions": {
+			policy:   `{"Statement":[{"Condition":{"Bool":{"aws:SecureTransport":false},"NumericLessThan":{"s3:max-keys":[10]},"StringEquals":{"aws:SourceAccount":["222222222222","111111111111"]}}}]}`,
+			expected: `{"Statement":[{"Condition":{"Bool":{"aws:SecureTransport":"false"},"NumericLessThan":{"s3:max-keys":"10"},"StringEquals":{"aws:SourceAccount":["111111111111","222222222222"]}}}]}`,
+		},
+		"list of documents": {
+			policy:   `[{"Statement":[{"Principal":"*","Resource":["*"]}]}]`,
+			expected: `[{"Statement":[{"Principal":{"AWS":"*"},"Resource":"*"}]}]`,
+		},
+		"special characters": {
+			policy:   `{"Statement":[{"Condition":{"StringLike":{"aws:Referer":["https://example.com/*&a=<b>"]}}}]}`,
+			expected: `{"Statement":[{"Condition":{"StringLike":{"aws:Referer":"https://example.com/*&a=<b>"}}}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Canonicalize(testCase.policy)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("Canonicalize(%q) err %t, want %t", testCase.policy, got, want)
+			}
+			if got != testCase.expected {
+				t.Errorf("Canonicalize(%q) = %s, want %s", testCase.policy, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestStringsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1, policy2 string
+		expected         bool
+	}{
+		"empty": {
+			policy1:  "",
+			policy2:  "{}",
+			expected: true,
+		},
+		"invalid": {
+			policy1: `{"Statement":`,
+			policy2: `{"Statement":`,
+		},
+		"wildcard principal": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"kms:*","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"}]}`,
+			expected: true,
+		},
+		"wildcard principal service": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"kms:*","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"*"},"Action":"kms:*","Resource":"*"}]}`,
+		},
+		"account root principals": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["111111111111","arn:aws:iam::222222222222:root"]},"Action":"kms:*","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111111111111:root","arn:aws:iam::222222222222:root","222222222222"]},"Action":"kms:*","Resource":"*"}]}`,
+			expected: true,
+		},
+		"different accounts": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111111111111"},"Action":"kms:*","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::222222222222:root"},"Action":"kms:*","Resource":"*"}]}`,
+		},
+		"single-element conditions": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["false"]}}}]}`,
+			expected: true,
+		},
+		"role unique ID": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111111111111:role/example"},"Action":"s3:*","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"AROAEXAMPLEUNIQUEID12"},"Action":"s3:*","Resource":"*"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := StringsEquivalent(testCase.policy1, testCase.policy2), testCase.expected; got != want {
+				t.Errorf("StringsEquivalent(%q, %q) = %t, want %t", testCase.policy1, testCase.policy2, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package interceptors defines resource interceptors that work with both Terraform Plugin SDK v2 and Terraform Plugin Framework resources.
+// Interceptors implement cross-cutting behavior, such as audit logging and tag policy validation, once for both plugin protocols.
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// When represents the point in a resource request's lifecycle that an interceptor is run.
+// Multiple values can be ORed together.
+type When uint16
+
+const (
+	Before  When = 1 << iota // Interceptor is invoked before call to the resource's handler
+	After                    // Interceptor is invoked after successful call to the resource's handler
+	OnError                  // Interceptor is invoked after unsuccessful call to the resource's handler
+	Finally                  // Interceptor is invoked after After or OnError
+)
+
+// Why represents the resource operation(s) that an interceptor is run for.
+// Multiple values can be ORed together.
+type Why uint16
+
+const (
+	Create      Why = 1 << iota // Interceptor is invoked for a Create call
+	Read                        // Interceptor is invoked for a Read call
+	Update                      // Interceptor is invoked for an Update call
+	Delete                      // Interceptor is invoked for a Delete call
+	ModifyPlan                  // Interceptor is invoked for a ModifyPlan (Plugin SDK v2 CustomizeDiff) call
+	ImportState                 // Interceptor is invoked for an ImportState call
+
+	AllCRUDOps = Create | Read | Update | Delete                            // Interceptor is invoked for all CRUD calls
+	AllOps     = Create | Read | Update | Delete | ModifyPlan | ImportState // Interceptor is invoked for all calls
+)
+
+// ResourceData is a plugin protocol-independent view of a resource's data.
+type ResourceData interface {
+	// ID returns the resource's identifier, or "" if it is not known.
+	ID(context.Context) string
+	// ChangedAttributes returns the sorted names of the top-level attributes whose planned values differ from their prior state values.
+	// Planned values that are not yet known are treated as changed.
+	ChangedAttributes(context.Context) []string
+	// ConfigMap returns the configured value of the specified top-level map of strings attribute.
+	// Elements whose values are not yet known are nil.
+	// known is false if the configuration is not available, for example on Read or Delete, or if the set of the map's keys is not yet known.
+	ConfigMap(ctx context.Context, name string) (m map[string]*string, known bool)
+}
+
+// ResourceRequest represents a single resource interceptor invocation.
+type ResourceRequest struct {
+	TypeName string // Terraform resource type name, e.g. aws_vpc.
+	When     When
+	Why      Why
+	Meta     any // Provider instance data, a *conns.AWSClient once configured.
+	Data     ResourceData
+	Err      error // The error returned by the resource's handler. Only set for OnError and Finally.
+}
+
+// ResourceInterceptorFunc is functionality invoked during a resource request's lifecycle.
+type ResourceInterceptorFunc func(context.Context, ResourceRequest) (context.Context, error)
+
+// ResourceInterceptor represents a resource interceptor and the points at which it is invoked.
+type ResourceInterceptor struct {
+	When        When
+	Why         Why
+	Interceptor ResourceInterceptorFunc
+}
+
+// ResourceInterceptors is a chain of resource interceptors.
+// If a Before interceptor returns an error then no further interceptors in the chain are run and neither is the resource's handler.
+// In other cases all interceptors in the chain are run.
+type ResourceInterceptors []ResourceInterceptor
+
+// Why returns the interceptors that run for the specified resource operation.
+func (s ResourceInterceptors) Why(why Why) ResourceInterceptors {
+	var interceptors ResourceInterceptors
+
+	for _, v := range s {
+		if v.Why&why != 0 {
+			interceptors = append(interceptors, v)
+		}
+	}
+
+	return interceptors
+}
+
+// Run invokes the specified resource handler, running the interceptors for the request's operation.
+// Before interceptors are run first to last; all other interceptors are run last to first.
+// The errors returned by interceptors are returned. Errors returned by the handler are not.
+func (s ResourceInterceptors) Run(ctx context.Context, request ResourceRequest, handler func(context.Context) error) []error {
+	var errs []error
+	forward := s.Why(request.Why)
+
+	request.When = Before
+	for _, v := range forward {
+		if v.When&request.When == 0 {
+			continue
+		}
+
+		var err error
+		ctx, err = v.Interceptor(ctx, request)
+
+		if err != nil {
+			errs = append(errs, err)
+
+			// Short circuit if any Before interceptor errors.
+			if !IsWarning(err) {
+				return errs
+			}
+		}
+	}
+
+	reverse := slices.Clone(forward)
+	slices.Reverse(reverse)
+
+	if err := handler(ctx); err != nil {
+		request.When = OnError
+		request.Err = err
+	} else {
+		request.When = After
+	}
+
+	for _, when := range []When{request.When, Finally} {
+		request.When = when
+		for _, v := range reverse {
+			if v.When&request.When == 0 {
+				continue
+			}
+
+			var err error
+			ctx, err = v.Interceptor(ctx, request)
+
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errs
+}
+
+// warning is an error that is reported as a warning diagnostic.
+type warning struct {
+	error
+}
+
+func (w warning) Unwrap() error {
+	return w.error
+}
+
+// NewWarning returns an error that is reported as a warning, rather than an error, diagnostic.
+// Warnings returned by Before interceptors do not prevent the resource's handler from running.
+func NewWarning(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return warning{error: err}
+}
+
+// IsWarning returns whether the specified error is reported as a warning diagnostic.
+func IsWarning(err error) bool {
+	var w warning
+	return errors.As(err, &w)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestResourceInterceptorsWhy(t *testing.T) {
+	t.Parallel()
+
+	f := func(ctx context.Context, request ResourceRequest) (context.Context, error) {
+		return ctx, nil
+	}
+	interceptors := ResourceInterceptors{
+		{When: Before, Why: Create | Update, Interceptor: f},
+		{When: Finally, Why: ModifyPlan, Interceptor: f},
+		{When: After, Why: AllOps, Interceptor: f},
+	}
+
+	testCases := []struct {
+		why      Why
+		expected int
+	}{
+		{Create, 2},
+		{Read, 1},
+		{Delete, 1},
+		{ModifyPlan, 2},
+		{ImportState, 1},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := len(interceptors.Why(testCase.why)), testCase.expected; got != want {
+			t.Errorf("length of interceptors.Why(%d) = %d, want %d", testCase.why, got, want)
+		}
+	}
+}
+
+func TestResourceInterceptorsRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	handlerErr := errors.New("handler failed")
+
+	testCases := map[string]struct {
+		beforeErr      error
+		handlerErr     error
+		expectedCalls  []string
+		expectedErrs   int
+		expectedErrArg error
+	}{
+		"success": {
+			expectedCalls: []string{"1:Before", "2:Before", "handler", "2:After", "1:After", "2:Finally", "1:Finally"},
+		},
+		"handler error": {
+			handlerErr:     handlerErr,
+			expectedCalls:  []string{"1:Before", "2:Before", "handler", "2:OnError", "1:OnError", "2:Finally", "1:Finally"},
+			expectedErrArg: handlerErr,
+		},
+		"before error": {
+			beforeErr:     errors.New("before failed"),
+			expectedCalls: []string{"1:Before"},
+			expectedErrs:  1,
+		},
+		"before warning": {
+			beforeErr:     NewWarning(errors.New("before warned")),
+			expectedCalls: []string{"1:Before", "2:Before", "handler", "2:After", "1:After", "2:Finally", "1:Finally"},
+			expectedErrs:  1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			var errArg error
+			interceptor := func(n int) ResourceInterceptorFunc {
+				return func(ctx context.Context, request ResourceRequest) (context.Context, error) {
+					var when string
+					switch request.When {
+					case Before:
+						when = "Before"
+					case After:
+						when = "After"
+					case OnError:
+						when = "OnError"
+					case Finally:
+						when = "Finally"
+						errArg = request.Err
+					}
+					calls = append(calls, fmt.Sprintf("%d:%s", n, when))
+
+					if n == 1 && request.When == Before {
+						return ctx, testCase.beforeErr
+					}
+
+					return ctx, nil
+				}
+			}
+			interceptors := ResourceInterceptors{
+				{When: Before | After | OnError | Finally, Why: Create, Interceptor: interceptor(1)},
+				{When: Before | After | OnError | Finally, Why: Create | Update, Interceptor: interceptor(2)},
+				{When: Before, Why: Delete, Interceptor: interceptor(3)},
+			}
+
+			errs := interceptors.Run(ctx, ResourceRequest{Why: Create}, func(context.Context) error {
+				calls = append(calls, "handler")
+				return testCase.handlerErr
+			})
+
+			if !slices.Equal(calls, testCase.expectedCalls) {
+				t.Errorf("calls = %q, want %q", calls, testCase.expectedCalls)
+			}
+			if got, want := len(errs), testCase.expectedErrs; got != want {
+				t.Errorf("length of errs = %d, want %d", got, want)
+			}
+			if !errors.Is(errArg, testCase.expectedErrArg) {
+				t.Errorf("request.Err = %v, want %v", errArg, testCase.expectedErrArg)
+			}
+		})
+	}
+}
+
+func TestIsWarning(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("test")
+
+	if IsWarning(err) {
+		t.Error("IsWarning(err) = true, want false")
+	}
+	if !IsWarning(NewWarning(err)) {
+		t.Error("IsWarning(NewWarning(err)) = false, want true")
+	}
+	if !IsWarning(fmt.Errorf("wrapped: %w", NewWarning(err))) {
+		t.Error("IsWarning(wrapped) = false, want true")
+	}
+	if NewWarning(nil) != nil {
+		t.Error("NewWarning(nil) != nil")
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"encoding/json"
+)
+
+// Normalize returns a valid JSON string with insignificant whitespace removed and object keys sorted.
+func Normalize(in string) (string, error) {
+	var v any
+
+	if err := json.Unmarshal([]byte(in), &v); err != nil {
+		return in, err
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		return in, err
+	}
+
+	return string(out), nil
+}
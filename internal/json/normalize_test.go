@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName      string
+		input         string
+		want          string
+		expectedError bool
+	}{
+		{
+			testName: "empty object",
+			input:    " { } ",
+			want:     `{}`,
+		},
+		{
+			testName: "sorted keys",
+			input: `{
+  "b": [1, 2],
+  "a": {"d": true, "c": null}
+}`,
+			want: `{"a":{"c":null,"d":true},"b":[1,2]}`,
+		},
+		{
+			testName:      "invalid JSON",
+			input:         `{"a":`,
+			want:          `{"a":`,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Normalize(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("Normalize(%q) err %t, want %t", testCase.input, got, want)
+			}
+			if got != testCase.want {
+				t.Errorf("Normalize(%q) = %q, want %q", testCase.input, got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	KeySuppressedDiffNew    = "new"
+	KeySuppressedDiffOld    = "old"
+	KeySuppressedDiffPath   = "tf_attribute_path"
+	KeySuppressedDiffReason = "reason"
+)
+
+// NormalizeFunc returns the normalized form of a value, used to explain why a difference was suppressed.
+type NormalizeFunc func(string) (string, error)
+
+// SuppressedDiffsEnabled returns whether suppressed differences are logged.
+func SuppressedDiffsEnabled() bool {
+	v, _ := strconv.ParseBool(os.Getenv(envvar.LogSuppressedDiffs))
+
+	return v
+}
+
+// LogSuppressedDiff logs, if enabled, that a Plugin SDK v2 difference suppression function suppressed
+// a difference between the old and new values of the attribute at the specified path.
+// The Plugin SDK does not pass a Context to difference suppression functions, so the standard logger is used.
+func LogSuppressedDiff(path, reason, old, new string, normalize NormalizeFunc) {
+	if old == new || !SuppressedDiffsEnabled() {
+		return
+	}
+
+	old, new = normalizeValue(old, normalize), normalizeValue(new, normalize)
+
+	log.Printf("[WARN] Suppressed difference: %s=%q %s=%q %s=%q %s=%q", KeySuppressedDiffPath, path, KeySuppressedDiffReason, reason, KeySuppressedDiffOld, old, KeySuppressedDiffNew, new)
+}
+
+// LogSuppressedDiffContext logs, if enabled, that a Plugin Framework semantic equality check suppressed
+// a difference between the prior and proposed new values of an attribute.
+// The Plugin Framework does not pass the attribute path to semantic equality checks, so only the
+// resource type from the Context identifies the attribute.
+func LogSuppressedDiffContext(ctx context.Context, reason, old, new string, normalize NormalizeFunc) {
+	if old == new || !SuppressedDiffsEnabled() {
+		return
+	}
+
+	tflog.Warn(ctx, "Suppressed difference", map[string]any{
+		KeySuppressedDiffReason: reason,
+		KeySuppressedDiffOld:    normalizeValue(old, normalize),
+		KeySuppressedDiffNew:    normalizeValue(new, normalize),
+	})
+}
+
+func normalizeValue(v string, normalize NormalizeFunc) string {
+	if normalize == nil {
+		return v
+	}
+
+	if n, err := normalize(v); err == nil {
+		return n
+	}
+
+	return v
+}
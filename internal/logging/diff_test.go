@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestLogSuppressedDiff(t *testing.T) {
+	normalize := func(s string) (string, error) {
+		return strings.Join(strings.Fields(s), ""), nil
+	}
+
+	testCases := map[string]struct {
+		enabled  string
+		old, new string
+		expected string
+	}{
+		"disabled": {
+			old: `{"a": 1}`,
+			new: `{"a":1}`,
+		},
+		"enabled": {
+			enabled:  "true",
+			old:      `{"a": 1}`,
+			new:      `{ "a":1 }`,
+			expected: `[WARN] Suppressed difference: tf_attribute_path="policy" reason="equivalent JSON" old="{\"a\":1}" new="{\"a\":1}"`,
+		},
+		"no difference": {
+			enabled: "true",
+			old:     `{"a":1}`,
+			new:     `{"a":1}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envvar.LogSuppressedDiffs, testCase.enabled)
+
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			log.SetFlags(0)
+			t.Cleanup(func() {
+				log.SetOutput(os.Stderr)
+				log.SetFlags(log.LstdFlags)
+			})
+
+			LogSuppressedDiff("policy", "equivalent JSON", testCase.old, testCase.new, normalize)
+
+			if got := strings.TrimSpace(buf.String()); got != testCase.expected {
+				t.Errorf("got %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -6,16 +6,22 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfinterceptors "github.com/hashicorp/terraform-provider-aws/internal/interceptors"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -248,6 +254,75 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// wrappedEphemeralResource represents a dispatcher for a Plugin Framework ephemeral resource.
+// Ephemeral resources have no state, so no interceptors are run.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -255,13 +330,19 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	typeName         string
+	// typedInterceptors are run for ModifyPlan and ImportState calls.
+	// For CRUD calls they are adapted and included in interceptors.
+	typedInterceptors tfinterceptors.ResourceInterceptors
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, typeName string, typedInterceptors tfinterceptors.ResourceInterceptors) resource.ResourceWithConfigure {
 	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:  bootstrapContext,
+		inner:             inner,
+		interceptors:      interceptors,
+		typeName:          typeName,
+		typedInterceptors: typedInterceptors,
 	}
 }
 
@@ -326,7 +407,10 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		response.Diagnostics = w.runTypedInterceptors(ctx, tfinterceptors.ImportState, frameworkResourceData{importID: request.ID}, func(ctx context.Context) diag.Diagnostics {
+			v.ImportState(ctx, request, response)
+			return response.Diagnostics
+		})
 
 		return
 	}
@@ -338,10 +422,42 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+	ctx = w.bootstrapContext(ctx, w.meta)
+	data := frameworkResourceData{state: &request.State, config: &request.Config, plan: &response.Plan, prior: &request.State}
+	response.Diagnostics = w.runTypedInterceptors(ctx, tfinterceptors.ModifyPlan, data, func(ctx context.Context) diag.Diagnostics {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			v.ModifyPlan(ctx, request, response)
+		}
+		return response.Diagnostics
+	})
+}
+
+// runTypedInterceptors invokes the specified handler, running any typed interceptors for the operation.
+func (w *wrappedResource) runTypedInterceptors(ctx context.Context, why tfinterceptors.Why, data frameworkResourceData, f func(context.Context) diag.Diagnostics) diag.Diagnostics {
+	interceptors := w.typedInterceptors.Why(why)
+	if len(interceptors) == 0 {
+		return f(ctx)
+	}
+
+	var diags diag.Diagnostics
+	request := tfinterceptors.ResourceRequest{
+		TypeName: w.typeName,
+		Why:      why,
+		Data:     data,
+	}
+
+	if w.meta != nil {
+		request.Meta = w.meta
+	}
+
+	for _, err := range interceptors.Run(ctx, request, func(ctx context.Context) error {
+		diags = f(ctx)
+		return fwdiag.DiagnosticsError(diags)
+	}) {
+		diags = appendTypedInterceptorErr(diags, err)
 	}
+
+	return diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -653,3 +769,168 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// typedResourceInterceptor adapts a plugin protocol-independent resource interceptor to a Plugin Framework resource interceptor.
+// The values of when are the same as those of tfinterceptors.When.
+type typedResourceInterceptor struct {
+	typeName    string
+	interceptor tfinterceptors.ResourceInterceptor
+}
+
+func (r typedResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, tfinterceptors.Create, when, meta, frameworkResourceData{state: &response.State, config: &request.Config, plan: &request.Plan}, diags)
+}
+
+func (r typedResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, tfinterceptors.Read, when, meta, frameworkResourceData{state: &response.State}, diags)
+}
+
+func (r typedResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, tfinterceptors.Update, when, meta, frameworkResourceData{state: &response.State, config: &request.Config, plan: &request.Plan, prior: &request.State}, diags)
+}
+
+func (r typedResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, tfinterceptors.Delete, when, meta, frameworkResourceData{state: &request.State}, diags)
+}
+
+func (r typedResourceInterceptor) run(ctx context.Context, why tfinterceptors.Why, when when, meta *conns.AWSClient, data frameworkResourceData, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.interceptor.When&tfinterceptors.When(when) == 0 || r.interceptor.Why&why == 0 {
+		return ctx, diags
+	}
+
+	request := tfinterceptors.ResourceRequest{
+		TypeName: r.typeName,
+		When:     tfinterceptors.When(when),
+		Why:      why,
+		Data:     data,
+	}
+
+	if meta != nil {
+		request.Meta = meta
+	}
+
+	if when&(OnError|Finally) != 0 && diags.HasError() {
+		request.Err = fwdiag.DiagnosticsError(diags)
+	}
+
+	ctx, err := r.interceptor.Interceptor(ctx, request)
+
+	return ctx, appendTypedInterceptorErr(diags, err)
+}
+
+// appendTypedInterceptorErr appends any error returned by a typed interceptor to the specified diagnostics.
+func appendTypedInterceptorErr(diags diag.Diagnostics, err error) diag.Diagnostics {
+	switch {
+	case err == nil:
+	case tfinterceptors.IsWarning(err):
+		diags.AddWarning("Resource Interceptor Warning", err.Error())
+	default:
+		diags.AddError("Resource Interceptor Error", err.Error())
+	}
+
+	return diags
+}
+
+// frameworkResourceData adapts Plugin Framework resource data to tfinterceptors.ResourceData.
+// Values are read when requested so that, for example, a new resource's ID is available after Create.
+type frameworkResourceData struct {
+	importID string       // Set for ImportState.
+	state    *tfsdk.State // The state from which the resource's ID is read.
+	config   *tfsdk.Config
+	plan     *tfsdk.Plan
+	prior    *tfsdk.State
+}
+
+func (d frameworkResourceData) ID(ctx context.Context) string {
+	if d.importID != "" {
+		return d.importID
+	}
+
+	if d.state == nil || d.state.Raw.IsNull() {
+		return ""
+	}
+
+	// Not all resources have an "id" attribute.
+	var id fwtypes.String
+	if d.state.GetAttribute(ctx, path.Root(names.AttrID), &id).HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
+
+func (d frameworkResourceData) ChangedAttributes(context.Context) []string {
+	if d.plan == nil {
+		return nil
+	}
+
+	var prior tftypes.Value
+	if d.prior != nil {
+		prior = d.prior.Raw
+	}
+
+	return changedAttributes(d.plan.Raw, prior)
+}
+
+func (d frameworkResourceData) ConfigMap(ctx context.Context, name string) (map[string]*string, bool) {
+	if d.config == nil || d.config.Raw.IsNull() {
+		return nil, false
+	}
+
+	var v fwtypes.Map
+	if d.config.GetAttribute(ctx, path.Root(name), &v).HasError() || v.IsUnknown() {
+		return nil, false
+	}
+
+	m := make(map[string]*string)
+	for k, v := range v.Elements() {
+		switch {
+		case v.IsUnknown():
+			m[k] = nil
+		case !v.IsNull():
+			if v, ok := v.(fwtypes.String); ok {
+				m[k] = v.ValueStringPointer()
+			}
+		}
+	}
+
+	return m, true
+}
+
+// changedAttributes returns the sorted names of the top-level attributes whose planned values differ from their prior state values.
+// Planned values that are not yet known are treated as changed.
+func changedAttributes(plan, state tftypes.Value) []string {
+	if plan.IsNull() || !plan.IsKnown() {
+		return nil
+	}
+
+	var planAttributes, stateAttributes map[string]tftypes.Value
+	if err := plan.As(&planAttributes); err != nil {
+		return nil
+	}
+	if !state.IsNull() && state.IsKnown() {
+		if err := state.As(&stateAttributes); err != nil {
+			return nil
+		}
+	}
+
+	var attributes []string
+
+	for name, v := range planAttributes {
+		if name == names.AttrID {
+			continue
+		}
+
+		if s, ok := stateAttributes[name]; ok {
+			if !v.Equal(s) {
+				attributes = append(attributes, name)
+			}
+		} else if !v.IsNull() {
+			attributes = append(attributes, name)
+		}
+	}
+
+	sort.Strings(attributes)
+
+	return attributes
+}
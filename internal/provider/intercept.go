@@ -5,7 +5,10 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"sort"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfinterceptors "github.com/hashicorp/terraform-provider-aws/internal/interceptors"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -153,6 +157,10 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	typeName         string
+	// typedInterceptors are run for ModifyPlan and ImportState calls.
+	// For CRUD calls they are adapted and included in interceptors.
+	typedInterceptors tfinterceptors.ResourceInterceptors
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -175,7 +183,14 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		var v []*schema.ResourceData
+		err := r.runTypedInterceptors(ctx, tfinterceptors.ImportState, meta, sdkResourceData{d: d}, func(ctx context.Context) error {
+			var err error
+			v, err = f(ctx, d, meta)
+			return err
+		})
+
+		return v, err
 	}
 }
 
@@ -183,10 +198,41 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		return r.runTypedInterceptors(ctx, tfinterceptors.ModifyPlan, meta, sdkResourceData{d: d}, func(ctx context.Context) error {
+			return f(ctx, d, meta)
+		})
 	}
 }
 
+// runTypedInterceptors invokes the specified handler, running any typed interceptors for the operation.
+// Plugin SDK v2 ImportState and CustomizeDiff functions cannot return warning diagnostics, so warnings are logged.
+func (r *wrappedResource) runTypedInterceptors(ctx context.Context, why tfinterceptors.Why, meta any, data tfinterceptors.ResourceData, f func(context.Context) error) error {
+	interceptors := r.typedInterceptors.Why(why)
+	if len(interceptors) == 0 {
+		return f(ctx)
+	}
+
+	var err error
+	request := tfinterceptors.ResourceRequest{
+		TypeName: r.typeName,
+		Why:      why,
+		Meta:     meta,
+		Data:     data,
+	}
+	for _, v := range interceptors.Run(ctx, request, func(ctx context.Context) error {
+		err = f(ctx)
+		return err
+	}) {
+		if tfinterceptors.IsWarning(v) {
+			tflog.Warn(ctx, v.Error())
+		} else {
+			err = errors.Join(err, v)
+		}
+	}
+
+	return err
+}
+
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = r.bootstrapContext(ctx, meta)
@@ -195,6 +241,134 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// typedResourceInterceptor adapts a plugin protocol-independent resource interceptor to a Plugin SDK v2 resource interceptor.
+// The values of when and why for CRUD operations are the same as those of tfinterceptors.When and tfinterceptors.Why.
+type typedResourceInterceptor struct {
+	typeName    string
+	interceptor tfinterceptors.ResourceInterceptor
+}
+
+func newTypedResourceInterceptorItem(typeName string, interceptor tfinterceptors.ResourceInterceptor) interceptorItem {
+	return interceptorItem{
+		when: when(interceptor.When),
+		why:  why(interceptor.Why & tfinterceptors.AllCRUDOps),
+		interceptor: typedResourceInterceptor{
+			typeName:    typeName,
+			interceptor: interceptor,
+		},
+	}
+}
+
+func (r typedResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	request := tfinterceptors.ResourceRequest{
+		TypeName: r.typeName,
+		When:     tfinterceptors.When(when),
+		Why:      tfinterceptors.Why(why),
+		Meta:     meta,
+		Data:     sdkResourceData{d: d},
+	}
+
+	if when&(OnError|Finally) != 0 && diags.HasError() {
+		request.Err = sdkdiag.DiagnosticsError(diags)
+	}
+
+	ctx, err := r.interceptor.Interceptor(ctx, request)
+
+	switch {
+	case err == nil:
+	case tfinterceptors.IsWarning(err):
+		diags = append(diags, errs.NewWarningDiagnostic(err.Error(), ""))
+	default:
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return ctx, diags
+}
+
+// sdkResourceData adapts Plugin SDK v2 resource data to tfinterceptors.ResourceData.
+type sdkResourceData struct {
+	d interface {
+		GetRawConfig() cty.Value
+		GetRawPlan() cty.Value
+		GetRawState() cty.Value
+		Id() string
+	}
+}
+
+func (d sdkResourceData) ID(context.Context) string {
+	return d.d.Id()
+}
+
+func (d sdkResourceData) ChangedAttributes(context.Context) []string {
+	return changedAttributes(d.d.GetRawPlan(), d.d.GetRawState())
+}
+
+func (d sdkResourceData) ConfigMap(_ context.Context, name string) (map[string]*string, bool) {
+	return configMap(d.d.GetRawConfig(), name)
+}
+
+// configMap returns the configured value of the specified top-level map of strings attribute.
+// Elements whose values are not yet known are nil.
+func configMap(config cty.Value, name string) (map[string]*string, bool) {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(name) {
+		return nil, false
+	}
+
+	v := config.GetAttr(name)
+	if !v.IsKnown() {
+		return nil, false
+	}
+
+	m := make(map[string]*string)
+	if v.IsNull() {
+		return m, true
+	}
+
+	for k, v := range v.AsValueMap() {
+		switch {
+		case !v.IsKnown():
+			m[k] = nil
+		case !v.IsNull():
+			m[k] = aws.String(v.AsString())
+		}
+	}
+
+	return m, true
+}
+
+// changedAttributes returns the sorted names of the top-level attributes whose planned values differ from their prior state values.
+// Planned values that are not yet known are treated as changed.
+func changedAttributes(plan, state cty.Value) []string {
+	if plan.IsNull() || !plan.IsKnown() || !plan.Type().IsObjectType() {
+		return nil
+	}
+
+	var attributes []string
+
+	for name := range plan.Type().AttributeTypes() {
+		if name == names.AttrID {
+			continue
+		}
+
+		v := plan.GetAttr(name)
+
+		if state.IsNull() || !state.IsKnown() || !state.Type().HasAttribute(name) {
+			if !v.IsNull() {
+				attributes = append(attributes, name)
+			}
+			continue
+		}
+
+		if !v.RawEquals(state.GetAttr(name)) {
+			attributes = append(attributes, name)
+		}
+	}
+
+	sort.Strings(attributes)
+
+	return attributes
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
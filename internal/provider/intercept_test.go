@@ -5,11 +5,17 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfinterceptors "github.com/hashicorp/terraform-provider-aws/internal/interceptors"
 )
 
 func TestInterceptorsWhy(t *testing.T) {
@@ -93,3 +99,206 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestChangedAttributes(t *testing.T) {
+	t.Parallel()
+
+	prior := cty.ObjectVal(map[string]cty.Value{
+		"id":          cty.StringVal("vpc-12345678"),
+		"cidr_block":  cty.StringVal("10.0.0.0/16"),
+		"description": cty.NullVal(cty.String),
+		"tags":        cty.MapVal(map[string]cty.Value{"Name": cty.StringVal("test")}),
+	})
+
+	testCases := map[string]struct {
+		plan     cty.Value
+		state    cty.Value
+		expected []string
+	}{
+		"create": {
+			plan: cty.ObjectVal(map[string]cty.Value{
+				"id":          cty.UnknownVal(cty.String),
+				"cidr_block":  cty.StringVal("10.0.0.0/16"),
+				"description": cty.NullVal(cty.String),
+				"tags":        cty.MapVal(map[string]cty.Value{"Name": cty.StringVal("test")}),
+			}),
+			state:    cty.NullVal(prior.Type()),
+			expected: []string{"cidr_block", "tags"},
+		},
+		"update": {
+			plan: cty.ObjectVal(map[string]cty.Value{
+				"id":          cty.StringVal("vpc-12345678"),
+				"cidr_block":  cty.StringVal("10.0.0.0/16"),
+				"description": cty.StringVal("updated"),
+				"tags":        cty.UnknownVal(cty.Map(cty.String)),
+			}),
+			state:    prior,
+			expected: []string{"description", "tags"},
+		},
+		"no changes": {
+			plan:  prior,
+			state: prior,
+		},
+		"delete": {
+			plan:  cty.NullVal(prior.Type()),
+			state: prior,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := changedAttributes(testCase.plan, testCase.state)
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("changedAttributes() = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestConfigMap(t *testing.T) {
+	t.Parallel()
+
+	config := func(tags cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"cidr_block": cty.StringVal("10.0.0.0/16"),
+			"tags":       tags,
+		})
+	}
+
+	testCases := map[string]struct {
+		config        cty.Value
+		expected      map[string]*string
+		expectedKnown bool
+	}{
+		"values": {
+			config: config(cty.MapVal(map[string]cty.Value{
+				"Name":  cty.StringVal("test"),
+				"Owner": cty.UnknownVal(cty.String),
+				"Team":  cty.NullVal(cty.String),
+			})),
+			expected: map[string]*string{
+				"Name":  aws.String("test"),
+				"Owner": nil,
+			},
+			expectedKnown: true,
+		},
+		"null": {
+			config:        config(cty.NullVal(cty.Map(cty.String))),
+			expected:      map[string]*string{},
+			expectedKnown: true,
+		},
+		"unknown": {
+			config: config(cty.UnknownVal(cty.Map(cty.String))),
+		},
+		"no attribute": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"cidr_block": cty.StringVal("10.0.0.0/16"),
+			}),
+		},
+		"no configuration": {
+			config: cty.NullVal(config(cty.NullVal(cty.Map(cty.String))).Type()),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, known := configMap(testCase.config, "tags")
+
+			if known != testCase.expectedKnown {
+				t.Errorf("known = %t, want %t", known, testCase.expectedKnown)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypedResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	// The typed interceptor values for CRUD operations must match the Plugin SDK v2 interceptor values.
+	for _, v := range []struct{ typed, sdk uint16 }{
+		{uint16(tfinterceptors.Before), uint16(Before)},
+		{uint16(tfinterceptors.After), uint16(After)},
+		{uint16(tfinterceptors.OnError), uint16(OnError)},
+		{uint16(tfinterceptors.Finally), uint16(Finally)},
+		{uint16(tfinterceptors.Create), uint16(Create)},
+		{uint16(tfinterceptors.Read), uint16(Read)},
+		{uint16(tfinterceptors.Update), uint16(Update)},
+		{uint16(tfinterceptors.Delete), uint16(Delete)},
+	} {
+		if v.typed != v.sdk {
+			t.Errorf("typed value %d != Plugin SDK v2 value %d", v.typed, v.sdk)
+		}
+	}
+
+	var requests []tfinterceptors.ResourceRequest
+	item := newTypedResourceInterceptorItem("aws_test", tfinterceptors.ResourceInterceptor{
+		When: tfinterceptors.Before | tfinterceptors.Finally,
+		Why:  tfinterceptors.Delete | tfinterceptors.ModifyPlan,
+		Interceptor: func(ctx context.Context, request tfinterceptors.ResourceRequest) (context.Context, error) {
+			requests = append(requests, request)
+
+			if request.When == tfinterceptors.Finally {
+				return ctx, tfinterceptors.NewWarning(errors.New("finally"))
+			}
+
+			return ctx, nil
+		},
+	})
+
+	if got, want := item.why, Delete; got != want {
+		t.Errorf("why = %d, want %d", got, want)
+	}
+
+	var del schema.DeleteContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		return sdkdiag.AppendErrorf(diags, "delete error")
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+	d.SetId("test-id")
+
+	diags := interceptedHandler(bootstrapContext, interceptorItems{item}, del, Delete)(context.Background(), d, 42)
+
+	if got, want := len(sdkdiag.Errors(diags)), 1; got != want {
+		t.Errorf("length of error diags = %d, want %d", got, want)
+	}
+	if got, want := len(sdkdiag.Warnings(diags)), 1; got != want {
+		t.Errorf("length of warning diags = %d, want %d", got, want)
+	}
+
+	if got, want := len(requests), 2; got != want {
+		t.Fatalf("length of requests = %d, want %d", got, want)
+	}
+	for _, request := range requests {
+		if got, want := request.TypeName, "aws_test"; got != want {
+			t.Errorf("TypeName = %q, want %q", got, want)
+		}
+		if got, want := request.Data.ID(context.Background()), "test-id"; got != want {
+			t.Errorf("ID = %q, want %q", got, want)
+		}
+		if got, want := request.Meta, any(42); got != want {
+			t.Errorf("Meta = %v, want %v", got, want)
+		}
+	}
+	if requests[0].Err != nil {
+		t.Errorf("Before Err = %v, want nil", requests[0].Err)
+	}
+	if requests[1].Err == nil {
+		t.Error("Finally Err = nil, want error")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,10 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/audit"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfinterceptors "github.com/hashicorp/terraform-provider-aws/internal/interceptors"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -51,6 +56,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File to which a JSON-lines record of every resource create, update and delete is appended. " +
+					"Records include the names, but not the values, of changed attributes.",
+			},
+			"concurrency_limit": concurrencyLimitSchema(),
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -152,6 +164,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": rateLimitSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -228,6 +241,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require and validate resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of resource tag keys to regular expressions that the tag values must match.",
+						},
+						"key_case": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.KeyCase](),
+							Description:      "Casing rule for resource tag keys. Valid values are `camel`, `kebab`, `lower`, `pascal`, `snake` and `upper`.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys required on all resources.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -290,7 +331,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, v.TagPolicyConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -367,14 +408,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, v.TagPolicyConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
+			// Provider-wide typed interceptors are run before any resource-specific ones.
+			typedInterceptors := tfinterceptors.ResourceInterceptors{
+				audit.ResourceInterceptor(),
+			}
+			if v.Tags != nil {
+				typedInterceptors = append(typedInterceptors, tftags.PolicyResourceInterceptor(servicePackageName))
+			}
+			typedInterceptors = append(typedInterceptors, v.Interceptors...)
 			interceptors := interceptorItems{}
 
+			for _, v := range typedInterceptors.Why(tfinterceptors.AllCRUDOps) {
+				interceptors = append(interceptors, newTypedResourceInterceptorItem(typeName, v))
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -411,8 +464,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			rs := &wrappedResource{
-				bootstrapContext: bootstrapContext,
-				interceptors:     interceptors,
+				bootstrapContext:  bootstrapContext,
+				interceptors:      interceptors,
+				typeName:          typeName,
+				typedInterceptors: typedInterceptors,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -434,6 +489,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
+			} else if len(typedInterceptors.Why(tfinterceptors.ModifyPlan)) > 0 {
+				r.CustomizeDiff = rs.CustomizeDiff(func(context.Context, *schema.ResourceDiff, any) error {
+					return nil
+				})
 			}
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
@@ -477,6 +536,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -532,6 +592,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("concurrency_limit"); ok && len(v.([]interface{})) > 0 {
+		concurrencyLimits, err := expandConcurrencyLimits(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ConcurrencyLimits = concurrencyLimits
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -571,6 +639,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, err := expandRateLimits(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -587,6 +663,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicyConfig, err := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
 		meta = v
@@ -838,6 +922,125 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func concurrencyLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with settings to limit the number of concurrent calls to AWS API operations.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"limit": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of concurrent calls. 0 means unlimited.",
+				},
+				"operation": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The AWS API operation name, e.g. CreateCluster.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, as used in the endpoints configuration block, e.g. eks.",
+				},
+			},
+		},
+	}
+}
+
+func expandConcurrencyLimits(_ context.Context, tfList []interface{}) (map[string]map[string]int, error) {
+	concurrencyLimits := make(map[string]map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("concurrency_limit: %w", err)
+		}
+
+		// Concurrency limits are applied by AWS SDK for Go v2 middleware and have no effect on AWS SDK for Go v1 clients.
+		if names.ClientSDKV1(service) {
+			return nil, fmt.Errorf("concurrency_limit: service (%s) is not supported", service)
+		}
+
+		operation := tfMap["operation"].(string)
+		if _, ok := concurrencyLimits[service]; !ok {
+			concurrencyLimits[service] = make(map[string]int)
+		}
+		if _, ok := concurrencyLimits[service][operation]; ok {
+			return nil, fmt.Errorf("concurrency_limit: duplicate service (%s) and operation (%s)", service, operation)
+		}
+		concurrencyLimits[service][operation] = tfMap["limit"].(int)
+	}
+
+	return concurrencyLimits, nil
+}
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with settings to limit the rate of calls to a service's AWS API.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of requests that can be made at once. Defaults to the rate rounded up.",
+				},
+				"rate": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatAtLeast(0.01),
+					Description:  "The maximum sustained number of requests per second.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, as used in the endpoints configuration block, e.g. route53.",
+				},
+			},
+		},
+	}
+}
+
+func expandRateLimits(_ context.Context, tfList []interface{}) (map[string]conns.RateLimit, error) {
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit: %w", err)
+		}
+
+		if _, ok := rateLimits[service]; ok {
+			return nil, fmt.Errorf("rate_limit: duplicate service (%s)", service)
+		}
+		// Rate limits are applied by AWS SDK for Go v2 middleware and have no effect on AWS SDK for Go v1 clients.
+		if names.ClientSDKV1(service) {
+			return nil, fmt.Errorf("rate_limit: service (%s) is not supported", service)
+		}
+		rateLimits[service] = conns.RateLimit{
+			Burst: tfMap["burst"].(int),
+			Rate:  tfMap["rate"].(float64),
+		}
+	}
+
+	return rateLimits, nil
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1073,36 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["allowed_values"].(map[string]interface{}); ok && len(v) > 0 {
+		policyConfig.AllowedValues = make(map[string]*regexp.Regexp, len(v))
+		for k, v := range v {
+			re, err := regexp.Compile(v.(string))
+			if err != nil {
+				return nil, fmt.Errorf("tag_policy: allowed_values (%s): %w", k, err)
+			}
+			policyConfig.AllowedValues[k] = re
+		}
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		policyConfig.KeyCase = tftags.KeyCase(v)
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+		slices.Sort(policyConfig.RequiredKeys)
+	}
+
+	return policyConfig, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
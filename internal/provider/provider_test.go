@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +60,138 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	results, err := expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service":   "eks",
+			"operation": "CreateCluster",
+			"limit":     2,
+		},
+		map[string]interface{}{
+			"service":   "msk",
+			"operation": "CreateClusterV2",
+			"limit":     0,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]map[string]int{
+		names.EKS: {
+			"CreateCluster": 2,
+		},
+		names.Kafka: {
+			"CreateClusterV2": 0,
+		},
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service":   "eks",
+			"operation": "CreateCluster",
+			"limit":     2,
+		},
+		map[string]interface{}{
+			"service":   "eks",
+			"operation": "CreateCluster",
+			"limit":     3,
+		},
+	})
+	if err == nil {
+		t.Error("expected error for duplicate service and operation")
+	}
+
+	_, err = expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service":   "unknown",
+			"operation": "CreateCluster",
+			"limit":     2,
+		},
+	})
+	if err == nil {
+		t.Error("expected error for unknown service")
+	}
+
+	_, err = expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service":   "ec2",
+			"operation": "RunInstances",
+			"limit":     2,
+		},
+	})
+	if err == nil {
+		t.Error("expected error for service using AWS SDK for Go v1")
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	results, err := expandRateLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service": "route53",
+			"rate":    2.5,
+			"burst":   5,
+		},
+		map[string]interface{}{
+			"service": "cloudfront",
+			"rate":    1.0,
+			"burst":   0,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]conns.RateLimit{
+		names.Route53: {
+			Rate:  2.5,
+			Burst: 5,
+		},
+		names.CloudFront: {
+			Rate: 1.0,
+		},
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = expandRateLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service": "route53",
+			"rate":    2.5,
+			"burst":   0,
+		},
+		map[string]interface{}{
+			"service": "route53",
+			"rate":    1.0,
+			"burst":   0,
+		},
+	})
+	if err == nil {
+		t.Error("expected error for duplicate service")
+	}
+
+	_, err = expandRateLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service": "ec2",
+			"rate":    2.5,
+			"burst":   0,
+		},
+	})
+	if err == nil {
+		t.Error("expected error for service using AWS SDK for Go v1")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
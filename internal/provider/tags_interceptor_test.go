@@ -89,7 +89,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
 		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
-			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, v.TagPolicyConfig)
 		}
 
 		return ctx
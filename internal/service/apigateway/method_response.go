@@ -106,7 +106,9 @@ func resourceMethodResponseCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	mutexKey := "api-gateway-method-response"
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway Method Response: %s", err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	const (
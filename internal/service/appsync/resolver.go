@@ -408,7 +408,9 @@ func resolverParseResourceID(id string) (string, string, string, error) {
 
 func retryResolverOp(ctx context.Context, apiID string, f func() (interface{}, error)) (interface{}, error) { //nolint:unparam
 	mutexKey := "appsync-schema-" + apiID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return nil, err
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	const (
@@ -193,7 +193,11 @@ func (r *keyValueStoreResource) Update(ctx context.Context, request resource.Upd
 	// Updating changes the etag of the key value store.
 	// Use a mutex serialize actions
 	mutexKey := kvsARN
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront Key Value Store (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	input := &cloudfront.UpdateKeyValueStoreInput{}
@@ -236,7 +240,11 @@ func (r *keyValueStoreResource) Delete(ctx context.Context, request resource.Del
 
 	// Use a mutex serialize actions
 	mutexKey := kvsARN
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront Key Value Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	input := &cloudfront.DeleteKeyValueStoreInput{
@@ -89,7 +89,11 @@ func (r *keyResource) Create(ctx context.Context, request resource.CreateRequest
 	// Adding a key changes the etag of the key value store.
 	// Use a mutex serialize actions
 	mutexKey := kvsARN
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront KeyValueStore (%s) Key (%s)", kvsARN, data.Key.ValueString()), err.Error())
+
+		return
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	etag, err := findETagByARN(ctx, conn, kvsARN)
@@ -182,7 +186,11 @@ func (r *keyResource) Update(ctx context.Context, request resource.UpdateRequest
 		// Updating a key changes the etag of the key value store.
 		// Use a mutex serialize actions
 		mutexKey := kvsARN
-		conns.GlobalMutexKV.Lock(mutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront KeyValueStore (%s) Key (%s)", kvsARN, new.Key.ValueString()), err.Error())
+
+			return
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 		etag, err := findETagByARN(ctx, conn, kvsARN)
@@ -231,7 +239,11 @@ func (r *keyResource) Delete(ctx context.Context, request resource.DeleteRequest
 	// Deleting a key changes the etag of the key value store.
 	// Use a mutex serialize actions
 	mutexKey := kvsARN
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront KeyValueStore Key (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	etag, err := findETagByARN(ctx, conn, kvsARN)
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ConcurrencyLimits returns the default limits on concurrent calls to this service package's AWS API operations.
+// Limits can be overridden using the provider's concurrency_limit configuration block.
+func (p *servicePackage) ConcurrencyLimits(ctx context.Context) []*types.ServicePackageConcurrencyLimit {
+	return []*types.ServicePackageConcurrencyLimit{
+		{
+			Operation: "ConnectDirectory",
+			Limit:     5,
+		},
+		{
+			Operation: "CreateDirectory",
+			Limit:     5,
+		},
+		{
+			Operation: "CreateMicrosoftAD",
+			Limit:     5,
+		},
+	}
+}
@@ -132,6 +132,7 @@ const (
 	errCodePrefixListVersionMismatch                               = "PrefixListVersionMismatch"
 	errCodeResourceNotReady                                        = "ResourceNotReady"
 	errCodeRouteAlreadyExists                                      = "RouteAlreadyExists"
+	errCodeRulesPerSecurityGroupLimitExceeded                      = "RulesPerSecurityGroupLimitExceeded"
 	errCodeSnapshotCreationPerVolumeRateExceeded                   = "SnapshotCreationPerVolumeRateExceeded"
 	errCodeTransitGatewayMulticastGroupMemberNotFound              = "TransitGatewayMulticastGroupMember.NotFound"
 	errCodeTransitGatewayMulticastGroupSourceNotFound              = "TransitGatewayMulticastGroupSource.NotFound"
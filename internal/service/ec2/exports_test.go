@@ -3,6 +3,12 @@
 
 package ec2
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceAMICopy                                  = resourceAMICopy
@@ -59,6 +65,7 @@ var (
 	ResourceRouteTable                               = resourceRouteTable
 	ResourceSecurityGroupEgressRule                  = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                 = newSecurityGroupIngressRuleResource
+	ResourceSecurityGroupRules                       = newSecurityGroupRulesResource
 	ResourceSnapshotCreateVolumePermission           = resourceSnapshotCreateVolumePermission
 	ResourceSpotDataFeedSubscription                 = resourceSpotDataFeedSubscription
 	ResourceSpotFleetRequest                         = resourceSpotFleetRequest
@@ -93,6 +100,7 @@ var (
 	ResourceVolumeAttachment                         = resourceVolumeAttachment
 
 	CustomFiltersSchema                                        = customFiltersSchema
+	DiffSecurityGroupRules                                     = diffSecurityGroupRules
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
 	FindAvailabilityZones                                      = findAvailabilityZones
@@ -203,5 +211,22 @@ var (
 )
 
 type (
-	IPProtocol = ipProtocol
+	IPProtocol                  = ipProtocol
+	SecurityGroupRulesRuleModel = securityGroupRulesRuleModel
 )
+
+func (diff *securityGroupRulesDiff) Authorize() []*ec2.IpPermission {
+	return diff.authorize
+}
+
+func (diff *securityGroupRulesDiff) Modify() []*ec2.SecurityGroupRuleUpdate {
+	return diff.modify
+}
+
+func (diff *securityGroupRulesDiff) Revoke() []string {
+	return diff.revoke
+}
+
+func (rule *securityGroupRulesRuleModel) Key(ctx context.Context) string {
+	return rule.key(ctx).String()
+}
@@ -79,6 +79,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newSecurityGroupRulesResource,
+			Name:    "Security Group Rules",
+		},
 	}
 }
 
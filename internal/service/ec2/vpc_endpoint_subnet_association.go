@@ -71,7 +71,9 @@ func resourceVPCEndpointSubnetAssociationCreate(ctx context.Context, d *schema.R
 	// See https://github.com/hashicorp/terraform-provider-aws/issues/3382.
 	// Prevent concurrent subnet association requests and delay between requests.
 	mk := "vpc_endpoint_subnet_association_" + endpointID
-	conns.GlobalMutexKV.Lock(mk)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mk); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Endpoint Subnet Association (%s): %s", id, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mk)
 
 	c := &retry.StateChangeConf{
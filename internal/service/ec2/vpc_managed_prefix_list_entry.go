@@ -71,7 +71,9 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+			return nil, err
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
@@ -148,7 +150,9 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+			return nil, err
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
@@ -434,7 +434,9 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 // looking for a rule depending on this security group. Otherwise, it will only look at
 // groups that this group knows about.
 func forceRevokeSecurityGroupRules(ctx context.Context, conn *ec2.EC2, id string, searchAll bool) error {
-	conns.GlobalMutexKV.Lock(id)
+	if err := conns.GlobalMutexKV.LockContext(ctx, id); err != nil {
+		return fmt.Errorf("revoking Security Group (%s) Rules: %w", id, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(id)
 
 	rules, err := rulesInSGsTouchingThis(ctx, conn, id, searchAll)
@@ -160,7 +160,9 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "authorizing Security Group (%s) Rule: %s", securityGroupID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -331,7 +333,9 @@ func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChange(names.AttrDescription) {
 		securityGroupID := d.Get("security_group_id").(string)
 
-		conns.GlobalMutexKV.Lock(securityGroupID)
+		if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Security Group (%s) Rule (%s) description: %s", securityGroupID, d.Id(), err)
+		}
 		defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 		sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -375,7 +379,9 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "revoking Security Group (%s) Rule (%s): %s", securityGroupID, d.Id(), err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -0,0 +1,634 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// securityGroupRulesBatchSize is the maximum number of rules sent in a single
+	// Authorize, Revoke or Modify API call.
+	securityGroupRulesBatchSize = 100
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules", name="Security Group Rules")
+func newSecurityGroupRulesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupRulesResource{}
+
+	return r, nil
+}
+
+type securityGroupRulesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*securityGroupRulesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules"
+}
+
+func (r *securityGroupRulesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	ruleBlock := schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[securityGroupRulesRuleModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr_ipv4": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv4CIDRNetworkAddress(),
+						stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("cidr_ipv4"),
+							path.MatchRelative().AtParent().AtName("cidr_ipv6"),
+							path.MatchRelative().AtParent().AtName("prefix_list_id"),
+							path.MatchRelative().AtParent().AtName("referenced_security_group_id"),
+						),
+					},
+				},
+				"cidr_ipv6": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv6CIDRNetworkAddress(),
+					},
+				},
+				names.AttrDescription: schema.StringAttribute{
+					Optional: true,
+				},
+				"from_port": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(-1, 65535),
+					},
+				},
+				"ip_protocol": schema.StringAttribute{
+					Required: true,
+				},
+				"prefix_list_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"referenced_security_group_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"to_port": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(-1, 65535),
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress":  ruleBlock,
+			"ingress": ruleBlock,
+		},
+	}
+}
+
+func (r *securityGroupRulesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	ingress, egress, diags := data.rules(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, securityGroupID, ingress, egress); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group Rules (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	_, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group Rules (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	ingress, egress, diags := data.rules(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var remoteIngress, remoteEgress []*ec2.SecurityGroupRule
+	for _, v := range output {
+		if aws.BoolValue(v.IsEgress) {
+			remoteEgress = append(remoteEgress, v)
+		} else {
+			remoteIngress = append(remoteIngress, v)
+		}
+	}
+
+	data.Ingress, diags = fwtypes.NewSetNestedObjectValueOfSlice(ctx, r.flattenRules(ctx, securityGroupID, securityGroupRuleTypeIngress, ingress, remoteIngress))
+	response.Diagnostics.Append(diags...)
+	data.Egress, diags = fwtypes.NewSetNestedObjectValueOfSlice(ctx, r.flattenRules(ctx, securityGroupID, securityGroupRuleTypeEgress, egress, remoteEgress))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := new.SecurityGroupID.ValueString()
+	ingress, egress, diags := new.rules(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, securityGroupID, ingress, egress); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group Rules (%s)", securityGroupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+
+	tflog.Debug(ctx, "deleting VPC Security Group Rules", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+	err := r.reconcile(ctx, securityGroupID, nil, nil)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Security Group Rules (%s)", securityGroupID), err.Error())
+
+		return
+	}
+}
+
+// reconcile brings the security group's rules in line with the desired ingress and egress rules.
+// Missing rules are authorized, rules whose only difference is their description are modified in place
+// and rules that are not desired are revoked, in that order. Each kind of change is sent in as few API calls as possible.
+func (r *securityGroupRulesResource) reconcile(ctx context.Context, securityGroupID string, ingress, egress []*securityGroupRulesRuleModel) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+		return err
+	}
+	defer conns.GlobalMutexKV.Unlock(securityGroupID)
+
+	if _, err := FindSecurityGroupByID(ctx, conn, securityGroupID); err != nil {
+		return err
+	}
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return fmt.Errorf("reading rules: %w", err)
+	}
+
+	var remoteIngress, remoteEgress []*ec2.SecurityGroupRule
+	for _, v := range output {
+		if aws.BoolValue(v.IsEgress) {
+			remoteEgress = append(remoteEgress, v)
+		} else {
+			remoteIngress = append(remoteIngress, v)
+		}
+	}
+
+	ingressDiff, err := diffSecurityGroupRules(ctx, ingress, remoteIngress, r.Meta().AccountID)
+
+	if err != nil {
+		return fmt.Errorf("ingress: %w", err)
+	}
+
+	egressDiff, err := diffSecurityGroupRules(ctx, egress, remoteEgress, r.Meta().AccountID)
+
+	if err != nil {
+		return fmt.Errorf("egress: %w", err)
+	}
+
+	// Authorize new rules before revoking old ones so that traffic allowed by both the old and new rule sets
+	// isn't interrupted. If authorization fails, rules authorized so far are revoked and the group is left unchanged.
+	var authorizedIngress, authorizedEgress []string
+	rollback := func() {
+		for _, chunk := range tfslices.Chunks(authorizedIngress, securityGroupRulesBatchSize) {
+			input := &ec2.RevokeSecurityGroupIngressInput{
+				GroupId:              aws.String(securityGroupID),
+				SecurityGroupRuleIds: aws.StringSlice(chunk),
+			}
+
+			if _, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+				tflog.Warn(ctx, "revoking ingress rules after failed authorization", map[string]interface{}{
+					"security_group_id": securityGroupID,
+					"error":             err.Error(),
+				})
+			}
+		}
+
+		for _, chunk := range tfslices.Chunks(authorizedEgress, securityGroupRulesBatchSize) {
+			input := &ec2.RevokeSecurityGroupEgressInput{
+				GroupId:              aws.String(securityGroupID),
+				SecurityGroupRuleIds: aws.StringSlice(chunk),
+			}
+
+			if _, err := conn.RevokeSecurityGroupEgressWithContext(ctx, input); err != nil {
+				tflog.Warn(ctx, "revoking egress rules after failed authorization", map[string]interface{}{
+					"security_group_id": securityGroupID,
+					"error":             err.Error(),
+				})
+			}
+		}
+	}
+	authorizeError := func(ruleType securityGroupRuleType, err error) error {
+		rollback()
+
+		if tfawserr.ErrCodeEquals(err, errCodeRulesPerSecurityGroupLimitExceeded) {
+			return fmt.Errorf("authorizing %s rules: the security group can't hold both the current and the new rules while they are replaced, "+
+				"apply the change in smaller steps or request a higher rules per security group quota: %w", ruleType, err)
+		}
+
+		return fmt.Errorf("authorizing %s rules: %w", ruleType, err)
+	}
+
+	for _, chunk := range tfslices.Chunks(ingressDiff.authorize, securityGroupRulesBatchSize) {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		output, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input)
+
+		if err != nil {
+			return authorizeError(securityGroupRuleTypeIngress, err)
+		}
+
+		for _, v := range output.SecurityGroupRules {
+			authorizedIngress = append(authorizedIngress, aws.StringValue(v.SecurityGroupRuleId))
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(egressDiff.authorize, securityGroupRulesBatchSize) {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: chunk,
+		}
+
+		output, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input)
+
+		if err != nil {
+			return authorizeError(securityGroupRuleTypeEgress, err)
+		}
+
+		for _, v := range output.SecurityGroupRules {
+			authorizedEgress = append(authorizedEgress, aws.StringValue(v.SecurityGroupRuleId))
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(append(ingressDiff.modify, egressDiff.modify...), securityGroupRulesBatchSize) {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(securityGroupID),
+			SecurityGroupRules: chunk,
+		}
+
+		if _, err := conn.ModifySecurityGroupRulesWithContext(ctx, input); err != nil {
+			return fmt.Errorf("modifying rule descriptions: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(ingressDiff.revoke, securityGroupRulesBatchSize) {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(chunk),
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(egressDiff.revoke, securityGroupRulesBatchSize) {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(chunk),
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// flattenRules returns the state representation of the remote rules.
+// Remote rules that match a rule in prior state keep that rule's values (e.g. protocol name vs. number)
+// apart from the description, so that only real changes show up as drift.
+func (r *securityGroupRulesResource) flattenRules(ctx context.Context, securityGroupID string, ruleType securityGroupRuleType, prior []*securityGroupRulesRuleModel, remote []*ec2.SecurityGroupRule) []*securityGroupRulesRuleModel {
+	accountID := r.Meta().AccountID
+
+	priorByKey := make(map[securityGroupRulesKey]*securityGroupRulesRuleModel, len(prior))
+	for _, v := range prior {
+		priorByKey[v.key(ctx)] = v
+	}
+
+	rules := make([]*securityGroupRulesRuleModel, 0, len(remote))
+	seen := make(map[securityGroupRulesKey]bool, len(remote))
+	for _, apiObject := range remote {
+		key := securityGroupRulesKeyFromAPIObject(ctx, apiObject, accountID)
+		seen[key] = true
+
+		rule, ok := priorByKey[key]
+		if !ok {
+			tflog.Warn(ctx, "VPC Security Group rule not managed by Terraform", map[string]interface{}{
+				"security_group_id":      securityGroupID,
+				"security_group_rule_id": aws.StringValue(apiObject.SecurityGroupRuleId),
+				names.AttrType:           ruleType,
+			})
+
+			rules = append(rules, flattenSecurityGroupRulesRule(ctx, apiObject, accountID))
+
+			continue
+		}
+
+		rule.Description = fwflex.EmptyStringAsNull(fwflex.StringToFramework(ctx, apiObject.Description))
+		rules = append(rules, rule)
+	}
+
+	for key := range priorByKey {
+		if !seen[key] {
+			tflog.Warn(ctx, "VPC Security Group rule removed outside of Terraform", map[string]interface{}{
+				"security_group_id": securityGroupID,
+				"rule":              key.String(),
+				names.AttrType:      ruleType,
+			})
+		}
+	}
+
+	return rules
+}
+
+type securityGroupRulesDiff struct {
+	authorize []*ec2.IpPermission
+	modify    []*ec2.SecurityGroupRuleUpdate
+	revoke    []string
+}
+
+// diffSecurityGroupRules computes the changes needed to turn the remote rules into the desired rules.
+func diffSecurityGroupRules(ctx context.Context, desired []*securityGroupRulesRuleModel, remote []*ec2.SecurityGroupRule, accountID string) (*securityGroupRulesDiff, error) {
+	diff := &securityGroupRulesDiff{}
+
+	desiredByKey := make(map[securityGroupRulesKey]*securityGroupRulesRuleModel, len(desired))
+	for _, v := range desired {
+		key := v.key(ctx)
+
+		if _, ok := desiredByKey[key]; ok {
+			return nil, fmt.Errorf("duplicate rule: %s", key)
+		}
+
+		desiredByKey[key] = v
+	}
+
+	remoteByKey := make(map[securityGroupRulesKey]*ec2.SecurityGroupRule, len(remote))
+	for _, apiObject := range remote {
+		key := securityGroupRulesKeyFromAPIObject(ctx, apiObject, accountID)
+		remoteByKey[key] = apiObject
+
+		v, ok := desiredByKey[key]
+		if !ok {
+			diff.revoke = append(diff.revoke, aws.StringValue(apiObject.SecurityGroupRuleId))
+
+			continue
+		}
+
+		if v.Description.ValueString() != aws.StringValue(apiObject.Description) {
+			diff.modify = append(diff.modify, &ec2.SecurityGroupRuleUpdate{
+				SecurityGroupRule:   v.model().expandSecurityGroupRuleRequest(ctx),
+				SecurityGroupRuleId: apiObject.SecurityGroupRuleId,
+			})
+		}
+	}
+
+	for _, v := range desired {
+		if _, ok := remoteByKey[v.key(ctx)]; !ok {
+			diff.authorize = append(diff.authorize, v.model().expandIPPermission(ctx))
+		}
+	}
+
+	return diff, nil
+}
+
+type securityGroupRulesResourceModel struct {
+	Egress          fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"egress"`
+	ID              types.String                                                `tfsdk:"id"`
+	Ingress         fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"ingress"`
+	SecurityGroupID types.String                                                `tfsdk:"security_group_id"`
+}
+
+func (model *securityGroupRulesResourceModel) InitFromID() error {
+	model.SecurityGroupID = model.ID
+
+	return nil
+}
+
+func (model *securityGroupRulesResourceModel) setID() {
+	model.ID = model.SecurityGroupID
+}
+
+func (model *securityGroupRulesResourceModel) rules(ctx context.Context) ([]*securityGroupRulesRuleModel, []*securityGroupRulesRuleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ingress, d := model.Ingress.ToSlice(ctx)
+	diags.Append(d...)
+	egress, d := model.Egress.ToSlice(ctx)
+	diags.Append(d...)
+
+	return ingress, egress, diags
+}
+
+type securityGroupRulesRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                types.String `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
+
+// model returns the equivalent single rule resource model, so that its expanders can be reused.
+func (rule *securityGroupRulesRuleModel) model() *securityGroupRuleResourceModel {
+	return &securityGroupRuleResourceModel{
+		CIDRIPv4:                  rule.CIDRIPv4,
+		CIDRIPv6:                  rule.CIDRIPv6,
+		Description:               rule.Description,
+		FromPort:                  rule.FromPort,
+		IPProtocol:                ipProtocol{StringValue: rule.IPProtocol},
+		PrefixListID:              rule.PrefixListID,
+		ReferencedSecurityGroupID: rule.ReferencedSecurityGroupID,
+		ToPort:                    rule.ToPort,
+	}
+}
+
+func (rule *securityGroupRulesRuleModel) key(context.Context) securityGroupRulesKey {
+	key := securityGroupRulesKey{
+		fromPort: -1,
+		protocol: protocolForValue(rule.IPProtocol.ValueString()),
+		toPort:   -1,
+	}
+
+	if key.protocol != "-1" {
+		if !rule.FromPort.IsNull() {
+			key.fromPort = rule.FromPort.ValueInt64()
+		}
+		if !rule.ToPort.IsNull() {
+			key.toPort = rule.ToPort.ValueInt64()
+		}
+	}
+
+	switch {
+	case !rule.CIDRIPv4.IsNull():
+		key.source = "cidr_ipv4=" + rule.CIDRIPv4.ValueString()
+	case !rule.CIDRIPv6.IsNull():
+		key.source = "cidr_ipv6=" + rule.CIDRIPv6.ValueString()
+	case !rule.PrefixListID.IsNull():
+		key.source = "prefix_list_id=" + rule.PrefixListID.ValueString()
+	case !rule.ReferencedSecurityGroupID.IsNull():
+		key.source = "referenced_security_group_id=" + rule.ReferencedSecurityGroupID.ValueString()
+	}
+
+	return key
+}
+
+func flattenSecurityGroupRulesRule(ctx context.Context, apiObject *ec2.SecurityGroupRule, accountID string) *securityGroupRulesRuleModel {
+	rule := &securityGroupRulesRuleModel{
+		CIDRIPv4:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv4),
+		CIDRIPv6:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv6),
+		Description:               fwflex.EmptyStringAsNull(fwflex.StringToFramework(ctx, apiObject.Description)),
+		FromPort:                  fwflex.Int64ToFramework(ctx, apiObject.FromPort),
+		IPProtocol:                fwflex.StringToFramework(ctx, apiObject.IpProtocol),
+		PrefixListID:              fwflex.StringToFramework(ctx, apiObject.PrefixListId),
+		ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, apiObject.ReferencedGroupInfo, accountID),
+		ToPort:                    fwflex.Int64ToFramework(ctx, apiObject.ToPort),
+	}
+
+	// All protocols implies all ports.
+	if protocolForValue(aws.StringValue(apiObject.IpProtocol)) == "-1" {
+		rule.FromPort = types.Int64Null()
+		rule.ToPort = types.Int64Null()
+	}
+
+	return rule
+}
+
+// securityGroupRulesKey identifies a rule within a security group independently of its description.
+type securityGroupRulesKey struct {
+	fromPort int64
+	protocol string
+	source   string
+	toPort   int64
+}
+
+func (key securityGroupRulesKey) String() string {
+	return fmt.Sprintf("%s %d-%d %s", key.protocol, key.fromPort, key.toPort, key.source)
+}
+
+func securityGroupRulesKeyFromAPIObject(ctx context.Context, apiObject *ec2.SecurityGroupRule, accountID string) securityGroupRulesKey {
+	return flattenSecurityGroupRulesRule(ctx, apiObject, accountID).key(ctx)
+}
@@ -0,0 +1,536 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSecurityGroupRulesRuleKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	rule := func(protocol string, fromPort, toPort int64) *tfec2.SecurityGroupRulesRuleModel {
+		return &tfec2.SecurityGroupRulesRuleModel{
+			CIDRIPv4:                  types.StringValue("10.0.0.0/8"),
+			CIDRIPv6:                  types.StringNull(),
+			Description:               types.StringNull(),
+			FromPort:                  types.Int64Value(fromPort),
+			IPProtocol:                types.StringValue(protocol),
+			PrefixListID:              types.StringNull(),
+			ReferencedSecurityGroupID: types.StringNull(),
+			ToPort:                    types.Int64Value(toPort),
+		}
+	}
+	withSource := func(rule *tfec2.SecurityGroupRulesRuleModel, name, value string) *tfec2.SecurityGroupRulesRuleModel {
+		rule.CIDRIPv4 = types.StringNull()
+		switch name {
+		case "cidr_ipv4":
+			rule.CIDRIPv4 = types.StringValue(value)
+		case "cidr_ipv6":
+			rule.CIDRIPv6 = types.StringValue(value)
+		case "prefix_list_id":
+			rule.PrefixListID = types.StringValue(value)
+		case "referenced_security_group_id":
+			rule.ReferencedSecurityGroupID = types.StringValue(value)
+		}
+		return rule
+	}
+	withDescription := func(rule *tfec2.SecurityGroupRulesRuleModel, description string) *tfec2.SecurityGroupRulesRuleModel {
+		rule.Description = types.StringValue(description)
+		return rule
+	}
+
+	testCases := map[string]struct {
+		a, b  *tfec2.SecurityGroupRulesRuleModel
+		equal bool
+	}{
+		"protocol name and number": {
+			a:     rule("tcp", 80, 80),
+			b:     rule("6", 80, 80),
+			equal: true,
+		},
+		"protocol case": {
+			a:     rule("UDP", 53, 53),
+			b:     rule("udp", 53, 53),
+			equal: true,
+		},
+		"all protocols ignores ports": {
+			a:     rule("-1", 0, 0),
+			b:     rule("all", -1, -1),
+			equal: true,
+		},
+		"different ports": {
+			a: rule("tcp", 80, 80),
+			b: rule("tcp", 80, 443),
+		},
+		"different protocols": {
+			a: rule("tcp", 53, 53),
+			b: rule("udp", 53, 53),
+		},
+		"description ignored": {
+			a:     withDescription(rule("tcp", 80, 80), "HTTP"),
+			b:     rule("tcp", 80, 80),
+			equal: true,
+		},
+		"cidr_ipv4 and cidr_ipv6": {
+			a: withSource(rule("tcp", 80, 80), "cidr_ipv4", "0.0.0.0/0"),
+			b: withSource(rule("tcp", 80, 80), "cidr_ipv6", "::/0"),
+		},
+		"prefix_list_id and referenced_security_group_id": {
+			a: withSource(rule("tcp", 80, 80), "prefix_list_id", "pl-12345678"),
+			b: withSource(rule("tcp", 80, 80), "referenced_security_group_id", "pl-12345678"),
+		},
+		"same referenced_security_group_id": {
+			a:     withSource(rule("tcp", 80, 80), "referenced_security_group_id", "sg-12345678"),
+			b:     withSource(rule("6", 80, 80), "referenced_security_group_id", "sg-12345678"),
+			equal: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, b := testCase.a.Key(ctx), testCase.b.Key(ctx)
+
+			if got := a == b; got != testCase.equal {
+				t.Errorf("keys %q and %q equal = %t, want %t", a, b, got, testCase.equal)
+			}
+		})
+	}
+}
+
+func TestDiffSecurityGroupRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const accountID = "123456789012"
+
+	desired := func(protocol string, fromPort, toPort int64, source, description string) *tfec2.SecurityGroupRulesRuleModel {
+		rule := &tfec2.SecurityGroupRulesRuleModel{
+			CIDRIPv4:                  types.StringNull(),
+			CIDRIPv6:                  types.StringNull(),
+			Description:               types.StringNull(),
+			FromPort:                  types.Int64Value(fromPort),
+			IPProtocol:                types.StringValue(protocol),
+			PrefixListID:              types.StringNull(),
+			ReferencedSecurityGroupID: types.StringNull(),
+			ToPort:                    types.Int64Value(toPort),
+		}
+		if protocol == "-1" {
+			rule.FromPort = types.Int64Null()
+			rule.ToPort = types.Int64Null()
+		}
+		if description != "" {
+			rule.Description = types.StringValue(description)
+		}
+		switch {
+		case strings.HasPrefix(source, "pl-"):
+			rule.PrefixListID = types.StringValue(source)
+		case strings.Contains(source, "sg-"):
+			rule.ReferencedSecurityGroupID = types.StringValue(source)
+		case strings.Contains(source, ":"):
+			rule.CIDRIPv6 = types.StringValue(source)
+		default:
+			rule.CIDRIPv4 = types.StringValue(source)
+		}
+		return rule
+	}
+	remote := func(id, protocol string, fromPort, toPort int64, source, description string) *ec2.SecurityGroupRule {
+		apiObject := &ec2.SecurityGroupRule{
+			FromPort:            aws.Int64(fromPort),
+			IpProtocol:          aws.String(protocol),
+			SecurityGroupRuleId: aws.String(id),
+			ToPort:              aws.Int64(toPort),
+		}
+		if description != "" {
+			apiObject.Description = aws.String(description)
+		}
+		switch {
+		case strings.HasPrefix(source, "pl-"):
+			apiObject.PrefixListId = aws.String(source)
+		case strings.Contains(source, "/sg-"):
+			userID, groupID, _ := strings.Cut(source, "/")
+			apiObject.ReferencedGroupInfo = &ec2.ReferencedSecurityGroup{GroupId: aws.String(groupID), UserId: aws.String(userID)}
+		case strings.HasPrefix(source, "sg-"):
+			apiObject.ReferencedGroupInfo = &ec2.ReferencedSecurityGroup{GroupId: aws.String(source), UserId: aws.String(accountID)}
+		case strings.Contains(source, ":"):
+			apiObject.CidrIpv6 = aws.String(source)
+		default:
+			apiObject.CidrIpv4 = aws.String(source)
+		}
+		return apiObject
+	}
+
+	testCases := map[string]struct {
+		desired       []*tfec2.SecurityGroupRulesRuleModel
+		remote        []*ec2.SecurityGroupRule
+		wantAuthorize int
+		wantModify    []string
+		wantRevoke    []string
+		expectError   bool
+	}{
+		"no rules": {},
+		"unchanged": {
+			desired: []*tfec2.SecurityGroupRulesRuleModel{
+				desired("6", 80, 80, "10.0.0.0/8", ""),
+				desired("-1", 0, 0, "::/0", ""),
+				desired("tcp", 443, 443, "pl-12345678", "HTTPS"),
+				desired("udp", 53, 53, "sg-12345678", ""),
+				desired("tcp", 22, 22, "210987654321/sg-87654321", ""),
+			},
+			remote: []*ec2.SecurityGroupRule{
+				remote("sgr-1", "tcp", 80, 80, "10.0.0.0/8", ""),
+				remote("sgr-2", "-1", -1, -1, "::/0", ""),
+				remote("sgr-3", "tcp", 443, 443, "pl-12345678", "HTTPS"),
+				remote("sgr-4", "udp", 53, 53, "sg-12345678", ""),
+				remote("sgr-5", "tcp", 22, 22, "210987654321/sg-87654321", ""),
+			},
+		},
+		"authorize": {
+			desired: []*tfec2.SecurityGroupRulesRuleModel{
+				desired("tcp", 80, 80, "10.0.0.0/8", ""),
+				desired("tcp", 443, 443, "10.0.0.0/8", ""),
+			},
+			remote: []*ec2.SecurityGroupRule{
+				remote("sgr-1", "tcp", 80, 80, "10.0.0.0/8", ""),
+			},
+			wantAuthorize: 1,
+		},
+		"revoke": {
+			desired: []*tfec2.SecurityGroupRulesRuleModel{
+				desired("tcp", 80, 80, "10.0.0.0/8", ""),
+			},
+			remote: []*ec2.SecurityGroupRule{
+				remote("sgr-1", "tcp", 80, 80, "10.0.0.0/8", ""),
+				remote("sgr-2", "tcp", 80, 80, "sg-12345678", ""),
+			},
+			wantRevoke: []string{"sgr-2"},
+		},
+		"port change": {
+			desired: []*tfec2.SecurityGroupRulesRuleModel{
+				desired("tcp", 8080, 8080, "10.0.0.0/8", ""),
+			},
+			remote: []*ec2.SecurityGroupRule{
+				remote("sgr-1", "tcp", 80, 80, "10.0.0.0/8", ""),
+			},
+			wantAuthorize: 1,
+			wantRevoke:    []string{"sgr-1"},
+		},
+		"source change": {
+			desired: []*tfec2.SecurityGroupRulesRuleModel{
+				desired("tcp", 80, 80, "10.0.0.0/16", ""),
+			},
+			remote: []*ec2.SecurityGroupRule{
+				remote("sgr-1", "tcp", 80, 80, "10.0.0.0/8", ""),
+			},
+			wantAuthorize: 1,
+			wantRevoke:    []string{"sgr-1"},
+		},
+		"description only": {
+			desired: []*tfec2.SecurityGroupRulesRuleModel{
+				desired("tcp", 80, 80, "10.0.0.0/8", "HTTP"),
+				desired("tcp", 443, 443, "10.0.0.0/8", ""),
+			},
+			remote: []*ec2.SecurityGroupRule{
+				remote("sgr-1", "tcp", 80, 80, "10.0.0.0/8", ""),
+				remote("sgr-2", "tcp", 443, 443, "10.0.0.0/8", "HTTPS"),
+			},
+			wantModify: []string{"sgr-1", "sgr-2"},
+		},
+		"duplicate keys": {
+			desired: []*tfec2.SecurityGroupRulesRuleModel{
+				desired("tcp", 80, 80, "10.0.0.0/8", "one"),
+				desired("6", 80, 80, "10.0.0.0/8", "two"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfec2.DiffSecurityGroupRules(ctx, testCase.desired, testCase.remote, accountID)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(got.Authorize()), testCase.wantAuthorize; got != want {
+				t.Errorf("authorize %d rules, want %d", got, want)
+			}
+
+			var modify []string
+			for _, v := range got.Modify() {
+				modify = append(modify, aws.StringValue(v.SecurityGroupRuleId))
+			}
+			slices.Sort(modify)
+			if !slices.Equal(modify, testCase.wantModify) {
+				t.Errorf("modify %v, want %v", modify, testCase.wantModify)
+			}
+
+			revoke := slices.Clone(got.Revoke())
+			slices.Sort(revoke)
+			if !slices.Equal(revoke, testCase.wantRevoke) {
+				t.Errorf("revoke %v, want %v", revoke, testCase.wantRevoke)
+			}
+		})
+	}
+}
+
+func TestAccVPCSecurityGroupRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2, 0),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"to_port":     "80",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2, 0),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSecurityGroupRules, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2, 0),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2, 1),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "egress.*", map[string]string{
+						"cidr_ipv4":   "0.0.0.0/0",
+						"ip_protocol": "-1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":           "10.0.0.0/8",
+						names.AttrDescription: "HTTPS",
+						"from_port":           "443",
+						"ip_protocol":         "tcp",
+						"to_port":             "443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv6":   "::/0",
+						"from_port":   "22",
+						"ip_protocol": "tcp",
+						"to_port":     "22",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_security_group_rules" {
+				continue
+			}
+
+			_, err := tfec2.FindSecurityGroupByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("VPC Security Group Rules still exist: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesCount(ctx context.Context, n string, wantIngress, wantEgress int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var gotIngress, gotEgress int
+		for _, v := range output {
+			if aws.BoolValue(v.IsEgress) {
+				gotEgress++
+			} else {
+				gotIngress++
+			}
+		}
+
+		if gotIngress != wantIngress || gotEgress != wantEgress {
+			return fmt.Errorf("VPC Security Group (%s) has %d ingress and %d egress rules, want %d and %d", rs.Primary.ID, gotIngress, gotEgress, wantIngress, wantEgress)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCSecurityGroupRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = "HTTPS"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+
+  ingress {
+    cidr_ipv6   = "::/0"
+    from_port   = 22
+    ip_protocol = "tcp"
+    to_port     = 22
+  }
+
+  egress {
+    cidr_ipv4   = "0.0.0.0/0"
+    ip_protocol = "-1"
+  }
+}
+`)
+}
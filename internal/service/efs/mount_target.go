@@ -119,7 +119,9 @@ func resourceMountTargetCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	fsID := d.Get(names.AttrFileSystemID).(string)
 	mtKey := "efs-mt-" + fsID + "-" + az
-	conns.GlobalMutexKV.Lock(mtKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mtKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EFS Mount Target (%s): %s", fsID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mtKey)
 
 	input := &efs.CreateMountTargetInput{
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_eks_cluster_auth", name="Cluster Auth")
+func newClusterAuthEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	r := &clusterAuthEphemeralResource{}
+
+	return r, nil
+}
+
+// clusterAuthEphemeralResource generates a new authentication token each time it is opened.
+// Terraform opens ephemeral resources separately for each plan and apply, so the token is never stale
+// at the start of an operation. The resource does not implement Renew as renewal cannot return a new token.
+type clusterAuthEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (r *clusterAuthEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_eks_cluster_auth"
+}
+
+func (r *clusterAuthEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *clusterAuthEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data clusterAuthEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().STSClient(ctx)
+
+	name := data.Name.ValueString()
+	generator, err := NewGenerator(false, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating EKS Cluster Authentication Token generator", err.Error())
+
+		return
+	}
+
+	token, err := generator.GetWithSTS(ctx, name, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EKS Cluster (%s) Authentication Token", name), err.Error())
+
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(token.Expiration)
+	data.Token = types.StringValue(token.Token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type clusterAuthEphemeralResourceModel struct {
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Name       types.String      `tfsdk:"name"`
+	Token      types.String      `tfsdk:"token"`
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterAuthEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.EKSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterAuthEphemeralConfig_basic(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrName), knownvalue.StringExact("foobar")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token"), clusterAuthTokenCheck{clusterName: "foobar"}),
+				},
+			},
+		},
+	})
+}
+
+// clusterAuthTokenCheck is a known value check that verifies an EKS cluster authentication token.
+type clusterAuthTokenCheck struct {
+	clusterName string
+}
+
+func (v clusterAuthTokenCheck) CheckValue(other any) error {
+	token, ok := other.(string)
+	if !ok {
+		return fmt.Errorf("expected string value for clusterAuthTokenCheck check, got: %T", other)
+	}
+
+	identity, err := tfeks.NewVerifier(v.clusterName).Verify(token)
+	if err != nil {
+		return fmt.Errorf("verifying token for cluster %q: %w", v.clusterName, err)
+	}
+	if identity.ARN == "" {
+		return fmt.Errorf("unexpected blank ARN for token identity")
+	}
+
+	return nil
+}
+
+func (v clusterAuthTokenCheck) String() string {
+	return fmt.Sprintf("EKS cluster (%s) authentication token", v.clusterName)
+}
+
+func testAccClusterAuthEphemeralConfig_basic() string {
+	return acctest.ConfigCompose(`
+ephemeral "aws_eks_cluster_auth" "test" {
+  name = "foobar"
+}
+`, acctest.ConfigWithEchoProvider("ephemeral.aws_eks_cluster_auth.test"))
+}
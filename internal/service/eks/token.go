@@ -104,7 +104,8 @@ const (
 
 // Token is generated and used by Kubernetes client-go to authenticate with a Kubernetes cluster.
 type Token struct {
-	Token string
+	Token      string
+	Expiration time.Time
 }
 
 // FormatError is returned when there is a problem with token that is
@@ -200,7 +201,9 @@ func (g generator) GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts
 		return Token{}, err
 	}
 
-	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL))}, nil
+	// Set token expiration to 1 minute before the presigned URL expires for some cushion
+	tokenExpiration := time.Now().Local().Add(presignedURLExpiration - 1*time.Minute)
+	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL)), tokenExpiration}, nil
 }
 
 func addClusterIdHeaderSetterMiddleware(clusterID string) func(*middleware.Stack) error {
@@ -113,7 +113,9 @@ func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	if v, ok := d.GetOk("zip_file"); ok {
-		conns.GlobalMutexKV.Lock(scriptMutex)
+		if err := conns.GlobalMutexKV.LockContext(ctx, scriptMutex); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating GameLift Script: %s", err)
+		}
 		defer conns.GlobalMutexKV.Unlock(scriptMutex)
 
 		file, err := loadFileContent(v.(string))
@@ -203,7 +205,9 @@ func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 		if d.HasChange("zip_file") {
 			if v, ok := d.GetOk("zip_file"); ok {
-				conns.GlobalMutexKV.Lock(scriptMutex)
+				if err := conns.GlobalMutexKV.LockContext(ctx, scriptMutex); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating GameLift Script: %s", err)
+				}
 				defer conns.GlobalMutexKV.Unlock(scriptMutex)
 
 				file, err := loadFileContent(v.(string))
@@ -181,7 +181,9 @@ func resourceOrganizationConfigurationPut(ctx context.Context, d *schema.Resourc
 
 	// We have seen occasional acceptance test failures when updating multiple features on the same detector concurrently,
 	// so use a mutex to ensure that multiple features being updated concurrently don't trample on each other.
-	conns.GlobalMutexKV.Lock(detectorID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, detectorID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Organization Configuration (%s): %s", detectorID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(detectorID)
 
 	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
@@ -75,10 +75,13 @@ func resourceOrganizationConfigurationFeaturePut(ctx context.Context, d *schema.
 	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
 
 	detectorID := d.Get("detector_id").(string)
+	name := d.Get(names.AttrName).(string)
 
 	// We have seen occasional acceptance test failures when updating multiple features on the same detector concurrently,
 	// so use a mutex to ensure that multiple features being updated concurrently don't trample on each other.
-	conns.GlobalMutexKV.Lock(detectorID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, detectorID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Organization Configuration (%s) Feature (%s): %s", detectorID, name, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(detectorID)
 
 	output, err := FindOrganizationConfigurationByID(ctx, conn, detectorID)
@@ -87,7 +90,6 @@ func resourceOrganizationConfigurationFeaturePut(ctx context.Context, d *schema.
 		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration (%s): %s", detectorID, err)
 	}
 
-	name := d.Get(names.AttrName).(string)
 	feature := &guardduty.OrganizationFeatureConfiguration{
 		AutoEnable: aws.String(d.Get("auto_enable").(string)),
 		Name:       aws.String(name),
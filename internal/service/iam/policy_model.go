@@ -82,6 +82,38 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// UnmarshalJSON decodes a policy document whose Statement is either a list of statements or a single statement.
+func (s *IAMPolicyDoc) UnmarshalJSON(b []byte) error {
+	var data struct {
+		Version    string
+		Id         string
+		Statements json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	var statements []*IAMPolicyStatement
+	if len(data.Statements) > 0 && data.Statements[0] == '{' {
+		var statement IAMPolicyStatement
+		if err := json.Unmarshal(data.Statements, &statement); err != nil {
+			return err
+		}
+		statements = append(statements, &statement)
+	} else if len(data.Statements) > 0 && string(data.Statements) != "null" {
+		if err := json.Unmarshal(data.Statements, &statements); err != nil {
+			return err
+		}
+	}
+
+	*s = IAMPolicyDoc{
+		Version:    data.Version,
+		Id:         data.Id,
+		Statements: statements,
+	}
+	return nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -155,7 +187,11 @@ func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range value.([]interface{}) {
-					values = append(values, v.(string))
+					identifier, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", v)
+					}
+					values = append(values, identifier)
 				}
 				sort.Strings(values)
 				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
@@ -218,14 +254,22 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 			switch var_values := var_values.(type) {
 			case string:
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
-			case bool:
-				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					value, err := policyConditionValue(v)
+					if err != nil {
+						return err
+					}
+					values = append(values, value)
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
+			default:
+				value, err := policyConditionValue(var_values)
+				if err != nil {
+					return err
+				}
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: value})
 			}
 		}
 	}
@@ -234,6 +278,23 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// policyConditionValue returns the string form of a condition value.
+// IAM compares boolean and numeric condition values as strings.
+func policyConditionValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("Unsupported data type %T for IAMPolicyStatementConditionSet", v)
+	}
+}
+
 func policyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
@@ -431,3 +431,125 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestIAMPolicyDoc_UnmarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy  string
+		want    string
+		wantErr bool
+	}{
+		"statement list": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"single statement": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"single statement whitespace": {
+			policy: "{\n  \"Statement\" : \n  {\"Effect\": \"Deny\", \"NotAction\": \"iam:*\", \"Resource\": \"*\"}\n}",
+			want:   `{"Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":"*"}]}`,
+		},
+		"no statement": {
+			policy: `{"Version":"2012-10-17"}`,
+			want:   `{"Version":"2012-10-17"}`,
+		},
+		"null statement": {
+			policy: `{"Version":"2012-10-17","Statement":null}`,
+			want:   `{"Version":"2012-10-17"}`,
+		},
+		"invalid statement": {
+			policy:  `{"Version":"2012-10-17","Statement":"invalid"}`,
+			wantErr: true,
+		},
+		"invalid principal": {
+			policy:  `{"Statement":{"Effect":"Allow","Principal":{"AWS":[1]},"Action":"sts:AssumeRole"}}`,
+			wantErr: true,
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc tfiam.IAMPolicyDoc
+			err := json.Unmarshal([]byte(tc.policy), &doc)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("IAMPolicyDoc.UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := json.Marshal(&doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("IAMPolicyDoc.UnmarshalJSON() = %s, want %s", string(got), tc.want)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyStatementConditionSet_UnmarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		condition string
+		want      tfiam.IAMPolicyStatementConditionSet
+		wantErr   bool
+	}{
+		"string": {
+			condition: `{"StringLike":{"s3:prefix":"one/"}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/"}},
+			},
+		},
+		"bool": {
+			condition: `{"Bool":{"aws:SecureTransport":false}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "Bool", Variable: "aws:SecureTransport", Values: "false"},
+			},
+		},
+		"number": {
+			condition: `{"NumericLessThan":{"aws:MultiFactorAuthAge":3600}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "NumericLessThan", Variable: "aws:MultiFactorAuthAge", Values: "3600"},
+			},
+		},
+		"mixed list": {
+			condition: `{"NumericEquals":{"s3:max-keys":["10",20.5,true]}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "NumericEquals", Variable: "s3:max-keys", Values: []string{"10", "20.5", acctest.CtTrue}},
+			},
+		},
+		"invalid value type": {
+			condition: `{"StringLike":{"s3:prefix":{"one":"two"}}}`,
+			wantErr:   true,
+		},
+		"invalid list value type": {
+			condition: `{"StringLike":{"s3:prefix":[null]}}`,
+			wantErr:   true,
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got tfiam.IAMPolicyStatementConditionSet
+			err := json.Unmarshal([]byte(tc.condition), &got)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("IAMPolicyStatementConditionSet.UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("IAMPolicyStatementConditionSet.UnmarshalJSON() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
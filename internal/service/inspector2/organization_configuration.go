@@ -129,7 +129,9 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return diags
 	}
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
+	if err := conns.GlobalMutexKV.LockContext(ctx, orgConfigMutex); err != nil {
+		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionUpdating, ResNameOrganizationConfiguration, d.Id(), err)
+	}
 	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
 
 	log.Printf("[DEBUG] Updating Inspector2 Organization Configuration (%s): %#v", d.Id(), in)
@@ -150,7 +152,9 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 
 	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
+	if err := conns.GlobalMutexKV.LockContext(ctx, orgConfigMutex); err != nil {
+		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionDeleting, ResNameOrganizationConfiguration, d.Id(), err)
+	}
 	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
 
 	in := &inspector2.UpdateOrganizationConfigurationInput{
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -32,3 +33,18 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 		},
 	), nil
 }
+
+// ConcurrencyLimits returns the default limits on concurrent calls to this service package's AWS API operations.
+// Limits can be overridden using the provider's concurrency_limit configuration block.
+func (p *servicePackage) ConcurrencyLimits(ctx context.Context) []*itypes.ServicePackageConcurrencyLimit {
+	return []*itypes.ServicePackageConcurrencyLimit{
+		{
+			Operation: "CreateCluster",
+			Limit:     5,
+		},
+		{
+			Operation: "CreateClusterV2",
+			Limit:     5,
+		},
+	}
+}
@@ -486,7 +486,9 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 	if v, ok := d.GetOk("filename"); ok {
 		// Grab an exclusive lock so that we're only reading one function into memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364.
-		conns.GlobalMutexKV.Lock(mutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 		zipFile, err := readFileContents(v.(string))
@@ -948,7 +950,9 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(mutexKey)
+			if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+			}
 			defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 			zipFile, err := readFileContents(v.(string))
@@ -166,7 +166,9 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 
 	var layerContent *awstypes.LayerVersionContentInput
 	if hasFilename {
-		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, mutexLayerKey); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing Lambda Layer (%s) Version: %s", layerName, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 
 		file, err := readFileContents(filename.(string))
@@ -123,7 +123,9 @@ func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, functionName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding Lambda Permission (%s/%s): %s", functionName, statementID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(functionName)
 
 	input := &lambda.AddPermissionInput{
@@ -248,7 +250,9 @@ func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, functionName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "removing Lambda Permission (%s/%s): %s", functionName, d.Id(), err)
+	}
 	defer conns.GlobalMutexKV.Unlock(functionName)
 
 	input := &lambda.RemovePermissionInput{
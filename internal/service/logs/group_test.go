@@ -20,6 +20,7 @@ import (
 
 func TestAccLogsGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.OfflineCapable(t)
 	var v types.LogGroup
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_log_group.test"
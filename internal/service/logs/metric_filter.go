@@ -126,7 +126,9 @@ func resourceMetricFilterPut(ctx context.Context, d *schema.ResourceData, meta i
 	// clashes, so use a mutex here (and on deletion) to serialise actions on
 	// log groups.
 	mutexKey := fmt.Sprintf(`log-group-%s`, logGroupName)
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting CloudWatch Logs Metric Filter (%s): %s", name, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	_, err := conn.PutMetricFilter(ctx, input)
@@ -178,7 +180,9 @@ func resourceMetricFilterDelete(ctx context.Context, d *schema.ResourceData, met
 	// clashes, so use a mutex here (and on creation) to serialise actions on
 	// log groups.
 	mutexKey := fmt.Sprintf(`log-group-%s`, d.Get(names.AttrLogGroupName))
-	conns.GlobalMutexKV.Lock(mutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Metric Filter (%s): %s", d.Id(), err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	log.Printf("[INFO] Deleting CloudWatch Logs Metric Filter: %s", d.Id())
@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_opensearch_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearch_domain", &resource.Sweeper{
 		Name: "aws_opensearch_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opensearch_inbound_connection_accepter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearch_inbound_connection_accepter", &resource.Sweeper{
 		Name: "aws_opensearch_inbound_connection_accepter",
 		F:    sweepInboundConnections,
 	})
 
-	resource.AddTestSweepers("aws_opensearch_outbound_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearch_outbound_connection", &resource.Sweeper{
 		Name: "aws_opensearch_outbound_connection",
 		F:    sweepOutboundConnections,
 	})
@@ -201,7 +201,9 @@ func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.Resource
 		// Grab an exclusive lock so that we're only reading one contact flow into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(cevMutexKey)
+		if err := conns.GlobalMutexKV.LockContext(ctx, cevMutexKey); err != nil {
+			return create.AppendDiagError(diags, names.RDS, create.ErrActionCreating, ResNameCustomDBEngineVersion, fmt.Sprintf("%s:%s", aws.StringValue(input.Engine), aws.StringValue(input.EngineVersion)), err)
+		}
 		defer conns.GlobalMutexKV.Unlock(cevMutexKey)
 		file, err := resourceCustomDBEngineVersionLoadFileContent(filename)
 		if err != nil {
@@ -13,12 +13,18 @@ var (
 	ResourceKeySigningKey               = resourceKeySigningKey
 	ResourceQueryLog                    = resourceQueryLog
 	ResourceRecord                      = resourceRecord
+	ResourceRecords                     = resourceRecords
 	ResourceTrafficPolicy               = resourceTrafficPolicy
 	ResourceTrafficPolicyInstance       = resourceTrafficPolicyInstance
 	ResourceVPCAssociationAuthorization = resourceVPCAssociationAuthorization
 	ResourceZone                        = resourceZone
 	ResourceZoneAssociation             = resourceZoneAssociation
 
+	ChangeBatches                               = changeBatches
+	DiffRecords                                 = diffRecords
+	PartialRecords                              = partialRecords
+	ReconcileRecords                            = reconcileRecords
+	RecordsRecordHash                           = recordsRecordHash
 	CleanDelegationSetID                        = cleanDelegationSetID
 	CleanRecordName                             = cleanRecordName
 	CleanZoneID                                 = cleanZoneID
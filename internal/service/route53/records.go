@@ -0,0 +1,751 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Limits on a single ChangeResourceRecordSets request.
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+const (
+	changeBatchMaxChanges         = 1000
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueLength     = 32000
+)
+
+// @SDKResource("aws_route53_records", name="Records")
+func resourceRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsCreate,
+		ReadWithoutTimeout:   resourceRecordsRead,
+		UpdateWithoutTimeout: resourceRecordsUpdate,
+		DeleteWithoutTimeout: resourceRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRecordsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     recordsRecordResource(),
+				Set:      recordsRecordHash,
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func recordsRecordResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrAlias: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluate_target_health": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							StateFunc:    normalizeAliasName,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"zone_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+					},
+				},
+			},
+			"failover_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResourceRecordSetFailover](),
+						},
+					},
+				},
+			},
+			"health_check_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"latency_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRegion: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResourceRecordSetRegion](),
+						},
+					},
+				},
+			},
+			"multivalue_answer_routing_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(strings.TrimSuffix(v.(string), "."))
+				},
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"set_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RRType](),
+			},
+			"weighted_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrWeight: {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// recordsRecordResourceHash hashes a record. The record schema is built once as it is used for every set operation.
+var recordsRecordResourceHash = schema.HashResource(recordsRecordResource())
+
+// recordsRecordHash hashes a record after normalizing its name and alias name,
+// so that configured values that differ only in case or a trailing period hash the same as their state values.
+func recordsRecordHash(v interface{}) int {
+	tfMap := make(map[string]interface{}, len(v.(map[string]interface{})))
+	for k, v := range v.(map[string]interface{}) {
+		tfMap[k] = v
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok {
+		tfMap[names.AttrName] = strings.ToLower(strings.TrimSuffix(v, "."))
+	}
+
+	if v, ok := tfMap[names.AttrAlias].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := make(map[string]interface{}, len(v[0].(map[string]interface{})))
+		for k, v := range v[0].(map[string]interface{}) {
+			alias[k] = v
+		}
+		alias[names.AttrName] = normalizeAliasName(alias[names.AttrName])
+		tfMap[names.AttrAlias] = []interface{}{alias}
+	}
+
+	return recordsRecordResourceHash(tfMap)
+}
+
+func resourceRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zoneID := cleanZoneID(d.Get("zone_id").(string))
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+
+	// Protect existing DNS records which might be managed in another way.
+	action := awstypes.ChangeActionCreate
+	if d.Get("allow_overwrite").(bool) {
+		action = awstypes.ChangeActionUpsert
+	}
+
+	var changes [][]awstypes.Change
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		changes = append(changes, []awstypes.Change{{
+			Action:            action,
+			ResourceRecordSet: expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName),
+		}})
+	}
+
+	// Set the ID before submitting any changes so that the records of batches that succeed
+	// are kept in state if a later batch fails.
+	d.SetId(zoneID)
+
+	if applied, err := changeResourceRecordSets(ctx, conn, zoneID, changes, "Managed by Terraform"); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "creating Route 53 Records (%s): %s", zoneID, err)
+
+		return append(diags, resourceRecordsSetPartial(ctx, conn, d, partialRecords(nil, d.Get("record").(*schema.Set).List(), applied, zoneName), zoneName)...)
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zone, err := findHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing Records from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	remote, err := findResourceRecordSetsByZone(ctx, conn, d.Id(), zoneName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("record", reconcileRecords(d.Get("record").(*schema.Set).List(), remote, zoneName)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+func resourceRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	if d.HasChange("record") {
+		zone, err := findHostedZoneByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+		}
+
+		zoneName := aws.ToString(zone.HostedZone.Name)
+		remote, err := findResourceRecordSetsByZone(ctx, conn, d.Id(), zoneName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+		}
+
+		o, n := d.GetChange("record")
+		changes := diffRecords(o.(*schema.Set), n.(*schema.Set), remote, zoneName, d.Get("allow_overwrite").(bool))
+
+		if applied, err := changeResourceRecordSets(ctx, conn, d.Id(), changes, "Managed by Terraform"); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+
+			return append(diags, resourceRecordsSetPartial(ctx, conn, d, partialRecords(o.(*schema.Set).List(), n.(*schema.Set).List(), applied, zoneName), zoneName)...)
+		}
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zone, err := findHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	remote, err := findResourceRecordSetsByZone(ctx, conn, d.Id(), zoneName)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	changes := diffRecords(d.Get("record").(*schema.Set), schema.NewSet(d.Get("record").(*schema.Set).F, nil), remote, zoneName, false)
+
+	log.Printf("[DEBUG] Deleting Route 53 Records: %s", d.Id())
+	if _, err := changeResourceRecordSets(ctx, conn, d.Id(), changes, "Deleted by Terraform"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zone, err := findHostedZoneByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", d.Id(), err)
+	}
+
+	remote, err := findResourceRecordSetsByZone(ctx, conn, d.Id(), aws.ToString(zone.HostedZone.Name))
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Records (%s): %w", d.Id(), err)
+	}
+
+	// Take ownership of every record that can be managed.
+	var tfList []interface{}
+	for _, v := range remote {
+		tfList = append(tfList, flattenRecordsResourceRecordSet(&v, ""))
+	}
+
+	if err := d.Set("record", tfList); err != nil {
+		return nil, fmt.Errorf("setting record: %w", err)
+	}
+	d.Set("zone_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceRecordsSetPartial sets the records known to be managed after a failed change, as currently stored in Route 53.
+// If they can't be listed, the prior state is kept.
+func resourceRecordsSetPartial(ctx context.Context, conn *route53.Client, d *schema.ResourceData, prior []interface{}, zoneName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	remote, err := findResourceRecordSetsByZone(ctx, conn, d.Id(), zoneName)
+
+	if err != nil {
+		d.Partial(true)
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("record", reconcileRecords(prior, remote, zoneName)); err != nil {
+		d.Partial(true)
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+
+	return diags
+}
+
+// reconcileRecords returns the prior records that exist in Route 53, as currently stored there.
+// Records that are not in prior are never adopted, even if prior is empty.
+func reconcileRecords(prior []interface{}, remote []awstypes.ResourceRecordSet, zoneName string) []interface{} {
+	remoteByKey := make(map[string]awstypes.ResourceRecordSet, len(remote))
+	for _, v := range remote {
+		remoteByKey[resourceRecordSetKey(&v)] = v
+	}
+
+	tfList := make([]interface{}, 0, len(prior))
+	for _, tfMapRaw := range prior {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key := resourceRecordSetKey(expandRecordsResourceRecordSet(tfMap, zoneName))
+
+		v, ok := remoteByKey[key]
+		if !ok {
+			log.Printf("[WARN] Route 53 Record (%s) not found, removing from state", key)
+			continue
+		}
+
+		tfList = append(tfList, flattenRecordsResourceRecordSet(&v, tfMap[names.AttrName].(string)))
+	}
+
+	return tfList
+}
+
+// partialRecords returns the records that may be managed after a failed change of the old set of records into the new set:
+// the old records, and the new records whose changes were applied.
+// New records whose changes were not applied may belong to others, so they are excluded.
+func partialRecords(o, n []interface{}, applied []awstypes.Change, zoneName string) []interface{} {
+	appliedKeys := make(map[string]bool, len(applied))
+	for _, change := range applied {
+		if change.Action != awstypes.ChangeActionDelete {
+			appliedKeys[resourceRecordSetKey(change.ResourceRecordSet)] = true
+		}
+	}
+
+	var tfList []interface{}
+	seen := make(map[string]bool, len(o)+len(n))
+	for _, tfMapRaw := range n {
+		key := resourceRecordSetKey(expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName))
+		if appliedKeys[key] {
+			tfList = append(tfList, tfMapRaw)
+			seen[key] = true
+		}
+	}
+	for _, tfMapRaw := range o {
+		key := resourceRecordSetKey(expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName))
+		if !seen[key] {
+			tfList = append(tfList, tfMapRaw)
+			seen[key] = true
+		}
+	}
+
+	return tfList
+}
+
+// diffRecords returns the changes that turn the old set of records into the new set.
+// Deletions use the record set as currently stored in Route 53, as the API requires an exact match.
+// Changes are grouped by record name, each group's deletions coming first, so that a record set
+// can be replaced by one of a different type (e.g. CNAME to A) in a single ChangeBatch.
+// Groups containing deletions come first.
+func diffRecords(o, n *schema.Set, remote []awstypes.ResourceRecordSet, zoneName string, allowOverwrite bool) [][]awstypes.Change {
+	remoteByKey := make(map[string]awstypes.ResourceRecordSet, len(remote))
+	for _, v := range remote {
+		remoteByKey[resourceRecordSetKey(&v)] = v
+	}
+
+	oldByKey := make(map[string]interface{}, o.Len())
+	for _, tfMapRaw := range o.List() {
+		oldByKey[resourceRecordSetKey(expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName))] = tfMapRaw
+	}
+
+	var recordNames []string
+	deletes := make(map[string][]awstypes.Change)
+	upserts := make(map[string][]awstypes.Change)
+	addName := func(name string) {
+		if _, ok := deletes[name]; ok {
+			return
+		}
+		if _, ok := upserts[name]; ok {
+			return
+		}
+		recordNames = append(recordNames, name)
+	}
+
+	newKeys := make(map[string]bool, n.Len())
+	for _, tfMapRaw := range n.List() {
+		apiObject := expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName)
+		key := resourceRecordSetKey(apiObject)
+		newKeys[key] = true
+
+		var action awstypes.ChangeAction
+		old, ok := oldByKey[key]
+		switch {
+		case !ok && !allowOverwrite:
+			action = awstypes.ChangeActionCreate
+		case !ok || o.F(old) != n.F(tfMapRaw):
+			action = awstypes.ChangeActionUpsert
+		default:
+			continue
+		}
+
+		name := normalizeZoneName(cleanRecordName(aws.ToString(apiObject.Name)))
+		addName(name)
+		upserts[name] = append(upserts[name], awstypes.Change{
+			Action:            action,
+			ResourceRecordSet: apiObject,
+		})
+	}
+
+	for key := range oldByKey {
+		if newKeys[key] {
+			continue
+		}
+
+		if v, ok := remoteByKey[key]; ok {
+			name := normalizeZoneName(cleanRecordName(aws.ToString(v.Name)))
+			addName(name)
+			deletes[name] = append(deletes[name], awstypes.Change{
+				Action:            awstypes.ChangeActionDelete,
+				ResourceRecordSet: &v,
+			})
+		}
+	}
+
+	var changes, rest [][]awstypes.Change
+	for _, name := range recordNames {
+		if len(deletes[name]) > 0 {
+			changes = append(changes, append(deletes[name], upserts[name]...))
+		} else {
+			rest = append(rest, upserts[name])
+		}
+	}
+
+	return append(changes, rest...)
+}
+
+// changeResourceRecordSets submits groups of changes in as few ChangeBatches as the API limits allow
+// and waits for all of them to be propagated.
+// The changes of the ChangeBatches that were accepted are returned, even if a later ChangeBatch fails.
+func changeResourceRecordSets(ctx context.Context, conn *route53.Client, zoneID string, changes [][]awstypes.Change, comment string) ([]awstypes.Change, error) {
+	var applied []awstypes.Change
+	var changeIDs []string
+
+	for _, batch := range changeBatches(changes) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String(comment),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
+			err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
+		}
+
+		if err != nil {
+			return applied, err
+		}
+
+		applied = append(applied, batch...)
+
+		if v := output.ChangeInfo; v != nil {
+			changeIDs = append(changeIDs, aws.ToString(v.Id))
+		}
+	}
+
+	for _, id := range changeIDs {
+		if _, err := waitChangeInsync(ctx, conn, id); err != nil {
+			return applied, fmt.Errorf("waiting for change (%s) synchronize: %w", id, err)
+		}
+	}
+
+	return applied, nil
+}
+
+// changeBatches splits groups of changes into batches that stay within the ChangeResourceRecordSets limits.
+// A group is only split across batches if it exceeds the limits on its own.
+// UPSERT changes count twice towards the limits.
+func changeBatches(groups [][]awstypes.Change) [][]awstypes.Change {
+	var batches [][]awstypes.Change
+	var batch []awstypes.Change
+	var nRecords, nValueLength int
+
+	for _, group := range groups {
+		var groupRecords, groupValueLength int
+		for _, change := range group {
+			records, valueLength := changeSize(change)
+			groupRecords += records
+			groupValueLength += valueLength
+		}
+
+		if len(batch) > 0 && (len(batch)+len(group) > changeBatchMaxChanges || nRecords+groupRecords > changeBatchMaxResourceRecords || nValueLength+groupValueLength > changeBatchMaxValueLength) {
+			batches = append(batches, batch)
+			batch, nRecords, nValueLength = nil, 0, 0
+		}
+
+		for _, change := range group {
+			records, valueLength := changeSize(change)
+
+			if len(batch) > 0 && (len(batch)+1 > changeBatchMaxChanges || nRecords+records > changeBatchMaxResourceRecords || nValueLength+valueLength > changeBatchMaxValueLength) {
+				batches = append(batches, batch)
+				batch, nRecords, nValueLength = nil, 0, 0
+			}
+
+			batch = append(batch, change)
+			nRecords += records
+			nValueLength += valueLength
+		}
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// changeSize returns the number of resource records and the total value length that a change counts towards the limits.
+func changeSize(change awstypes.Change) (int, int) {
+	weight := 1
+	if change.Action == awstypes.ChangeActionUpsert {
+		weight = 2
+	}
+
+	records, valueLength := 1, 0
+	if apiObject := change.ResourceRecordSet; apiObject != nil {
+		records = max(len(apiObject.ResourceRecords), 1)
+		for _, v := range apiObject.ResourceRecords {
+			valueLength += len(aws.ToString(v.Value))
+		}
+	}
+
+	return records * weight, valueLength * weight
+}
+
+// findResourceRecordSetsByZone returns all the record sets in the hosted zone apart from the zone's own NS and SOA records.
+func findResourceRecordSetsByZone(ctx context.Context, conn *route53.Client, zoneID, zoneName string) ([]awstypes.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	return findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		if normalizeZoneName(v.Name) == normalizeZoneName(zoneName) && (v.Type == awstypes.RRTypeNs || v.Type == awstypes.RRTypeSoa) {
+			return false
+		}
+		return true
+	})
+}
+
+// resourceRecordSetKey returns the name, type and set identifier that uniquely identify a record set in a hosted zone.
+func resourceRecordSetKey(apiObject *awstypes.ResourceRecordSet) string {
+	vars := []string{
+		normalizeZoneName(cleanRecordName(aws.ToString(apiObject.Name))),
+		string(apiObject.Type),
+	}
+	if v := aws.ToString(apiObject.SetIdentifier); v != "" {
+		vars = append(vars, v)
+	}
+
+	return strings.Join(vars, "_")
+}
+
+func expandRecordsResourceRecordSet(tfMap map[string]interface{}, zoneName string) *awstypes.ResourceRecordSet {
+	rrType := awstypes.RRType(tfMap[names.AttrType].(string))
+	apiObject := &awstypes.ResourceRecordSet{
+		Name: aws.String(expandRecordName(tfMap[names.AttrName].(string), zoneName)),
+		Type: rrType,
+	}
+
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRecords = expandResourceRecords(flex.ExpandStringValueSet(v), rrType)
+	}
+
+	if v, ok := tfMap[names.AttrAlias].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
+		apiObject.AliasTarget = &awstypes.AliasTarget{
+			DNSName:              aws.String(alias[names.AttrName].(string)),
+			EvaluateTargetHealth: alias["evaluate_target_health"].(bool),
+			HostedZoneId:         aws.String(alias["zone_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Failover = awstypes.ResourceRecordSetFailover(v[0].(map[string]interface{})[names.AttrType].(string))
+	}
+
+	if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+		apiObject.HealthCheckId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Region = awstypes.ResourceRecordSetRegion(v[0].(map[string]interface{})[names.AttrRegion].(string))
+	}
+
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		apiObject.MultiValueAnswer = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+		apiObject.SetIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Weight = aws.Int64(int64(v[0].(map[string]interface{})[names.AttrWeight].(int)))
+	}
+
+	return apiObject
+}
+
+// flattenRecordsResourceRecordSet returns the Terraform representation of the record set.
+// If name is empty the record set's normalized name is used, otherwise the configured name is kept.
+func flattenRecordsResourceRecordSet(apiObject *awstypes.ResourceRecordSet, name string) map[string]interface{} {
+	if name == "" {
+		name = normalizeZoneName(cleanRecordName(aws.ToString(apiObject.Name)))
+	}
+
+	tfMap := map[string]interface{}{
+		"health_check_id":                  aws.ToString(apiObject.HealthCheckId),
+		"multivalue_answer_routing_policy": aws.ToBool(apiObject.MultiValueAnswer),
+		names.AttrName:                     name,
+		"records":                          flattenResourceRecords(apiObject.ResourceRecords, apiObject.Type),
+		"set_identifier":                   aws.ToString(apiObject.SetIdentifier),
+		"ttl":                              aws.ToInt64(apiObject.TTL),
+		names.AttrType:                     apiObject.Type,
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap[names.AttrAlias] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": v.EvaluateTargetHealth,
+			names.AttrName:           normalizeAliasName(aws.ToString(v.DNSName)),
+			"zone_id":                aws.ToString(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.Failover; v != "" {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			names.AttrType: v,
+		}}
+	}
+
+	if v := apiObject.Region; v != "" {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			names.AttrRegion: v,
+		}}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			names.AttrWeight: aws.ToInt64(v),
+		}}
+	}
+
+	return tfMap
+}
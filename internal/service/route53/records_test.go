@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestChangeBatches(t *testing.T) {
+	t.Parallel()
+
+	change := func(action awstypes.ChangeAction, values ...string) awstypes.Change {
+		apiObject := &awstypes.ResourceRecordSet{
+			Name: aws.String("www.example.com"),
+			Type: awstypes.RRTypeA,
+		}
+		for _, v := range values {
+			apiObject.ResourceRecords = append(apiObject.ResourceRecords, awstypes.ResourceRecord{Value: aws.String(v)})
+		}
+
+		return awstypes.Change{Action: action, ResourceRecordSet: apiObject}
+	}
+	repeat := func(n int, c awstypes.Change) [][]awstypes.Change {
+		var changes [][]awstypes.Change
+		for range n {
+			changes = append(changes, []awstypes.Change{c})
+		}
+		return changes
+	}
+
+	testCases := map[string]struct {
+		changes [][]awstypes.Change
+		want    []int
+	}{
+		"none": {},
+		"single": {
+			changes: repeat(1, change(awstypes.ChangeActionCreate, "127.0.0.1")),
+			want:    []int{1},
+		},
+		"at change limit": {
+			changes: repeat(1000, change(awstypes.ChangeActionCreate, "127.0.0.1")),
+			want:    []int{1000},
+		},
+		"over change limit": {
+			changes: repeat(1001, change(awstypes.ChangeActionDelete, "127.0.0.1")),
+			want:    []int{1000, 1},
+		},
+		"upserts count twice": {
+			changes: repeat(600, change(awstypes.ChangeActionUpsert, "127.0.0.1")),
+			want:    []int{500, 100},
+		},
+		"resource record limit": {
+			changes: repeat(3, change(awstypes.ChangeActionCreate, strings.Split(strings.Repeat("127.0.0.1,", 400), ",")[:400]...)),
+			want:    []int{2, 1},
+		},
+		"value length limit": {
+			changes: repeat(3, change(awstypes.ChangeActionCreate, strings.Repeat("x", 12000))),
+			want:    []int{2, 1},
+		},
+		"groups kept together": {
+			changes: append(repeat(999, change(awstypes.ChangeActionCreate, "127.0.0.1")), []awstypes.Change{
+				change(awstypes.ChangeActionDelete, "127.0.0.1"),
+				change(awstypes.ChangeActionCreate, "127.0.0.2"),
+			}),
+			want: []int{999, 2},
+		},
+		"group over limit": {
+			changes: [][]awstypes.Change{slices.Concat(repeat(1001, change(awstypes.ChangeActionCreate, "127.0.0.1"))...)},
+			want:    []int{1000, 1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []int
+			for _, batch := range tfroute53.ChangeBatches(testCase.changes) {
+				got = append(got, len(batch))
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.want) {
+				t.Errorf("batch sizes = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDiffRecords(t *testing.T) {
+	t.Parallel()
+
+	const zoneName = "example.com."
+	record := testRecordsRecord
+	set := func(tfList ...interface{}) *schema.Set {
+		return schema.NewSet(tfroute53.RecordsRecordHash, tfList)
+	}
+	remote := testRecordsRemote
+	// summarize returns each group of changes as "ACTION NAME TYPE" strings.
+	summarize := func(groups [][]awstypes.Change) [][]string {
+		var got [][]string
+		for _, group := range groups {
+			var v []string
+			for _, change := range group {
+				v = append(v, fmt.Sprintf("%s %s %s", change.Action, aws.ToString(change.ResourceRecordSet.Name), change.ResourceRecordSet.Type))
+			}
+			got = append(got, v)
+		}
+		return got
+	}
+
+	testCases := map[string]struct {
+		old, new       *schema.Set
+		remote         []awstypes.ResourceRecordSet
+		allowOverwrite bool
+		want           [][]string
+	}{
+		"no changes": {
+			old:    set(record("www.example.com", "A", "127.0.0.1")),
+			new:    set(record("WWW.example.com.", "A", "127.0.0.1")),
+			remote: []awstypes.ResourceRecordSet{remote("www.example.com.", awstypes.RRTypeA, "127.0.0.1")},
+		},
+		"create": {
+			old: set(),
+			new: set(record("www.example.com", "A", "127.0.0.1")),
+			want: [][]string{
+				{"CREATE www.example.com A"},
+			},
+		},
+		"create allow overwrite": {
+			old:            set(),
+			new:            set(record("www.example.com", "A", "127.0.0.1")),
+			allowOverwrite: true,
+			want: [][]string{
+				{"UPSERT www.example.com A"},
+			},
+		},
+		"update": {
+			old:    set(record("www.example.com", "A", "127.0.0.1")),
+			new:    set(record("www.example.com", "A", "127.0.0.2")),
+			remote: []awstypes.ResourceRecordSet{remote("www.example.com.", awstypes.RRTypeA, "127.0.0.1")},
+			want: [][]string{
+				{"UPSERT www.example.com A"},
+			},
+		},
+		"type change": {
+			old: set(record("www.example.com", "CNAME", "lb.example.net"), record("api.example.com", "A", "127.0.0.1")),
+			new: set(record("api.example.com", "A", "127.0.0.2"), record("www.example.com", "A", "127.0.0.1")),
+			remote: []awstypes.ResourceRecordSet{
+				remote("www.example.com.", awstypes.RRTypeCname, "lb.example.net"),
+				remote("api.example.com.", awstypes.RRTypeA, "127.0.0.1"),
+			},
+			want: [][]string{
+				{"DELETE www.example.com. CNAME", "CREATE www.example.com A"},
+				{"UPSERT api.example.com A"},
+			},
+		},
+		"delete": {
+			old: set(record("www.example.com", "A", "127.0.0.1"), record("api.example.com", "A", "127.0.0.1")),
+			new: set(record("www.example.com", "A", "127.0.0.1")),
+			remote: []awstypes.ResourceRecordSet{
+				remote("www.example.com.", awstypes.RRTypeA, "127.0.0.1"),
+				remote("api.example.com.", awstypes.RRTypeA, "127.0.0.1"),
+			},
+			want: [][]string{
+				{"DELETE api.example.com. A"},
+			},
+		},
+		"delete already gone": {
+			old: set(record("api.example.com", "A", "127.0.0.1")),
+			new: set(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := summarize(tfroute53.DiffRecords(testCase.old, testCase.new, testCase.remote, zoneName, testCase.allowOverwrite))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileRecords(t *testing.T) {
+	t.Parallel()
+
+	const zoneName = "example.com."
+	remote := []awstypes.ResourceRecordSet{
+		testRecordsRemote("www.example.com.", awstypes.RRTypeA, "127.0.0.2"),
+		testRecordsRemote("other.example.com.", awstypes.RRTypeA, "127.0.0.1"),
+	}
+
+	testCases := map[string]struct {
+		prior []interface{}
+		want  []string
+	}{
+		"prior set empty after drift": {
+			prior: []interface{}{},
+		},
+		"refreshed": {
+			prior: []interface{}{testRecordsRecord("WWW.example.com.", "A", "127.0.0.1")},
+			want:  []string{"WWW.example.com. A 127.0.0.2"},
+		},
+		"removed": {
+			prior: []interface{}{testRecordsRecord("www.example.com", "A", "127.0.0.2"), testRecordsRecord("api.example.com", "A", "127.0.0.1")},
+			want:  []string{"www.example.com A 127.0.0.2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testRecordsSummarize(tfroute53.ReconcileRecords(testCase.prior, remote, zoneName))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPartialRecords(t *testing.T) {
+	t.Parallel()
+
+	const zoneName = "example.com."
+	change := func(action awstypes.ChangeAction, name string) awstypes.Change {
+		v := testRecordsRemote(name, awstypes.RRTypeA, "127.0.0.1")
+		return awstypes.Change{Action: action, ResourceRecordSet: &v}
+	}
+	old := []interface{}{
+		testRecordsRecord("www.example.com", "A", "127.0.0.1"),
+		testRecordsRecord("api.example.com", "A", "127.0.0.1"),
+	}
+	new := []interface{}{
+		testRecordsRecord("www.example.com", "A", "127.0.0.2"),
+		testRecordsRecord("new1.example.com", "A", "127.0.0.1"),
+		testRecordsRecord("new2.example.com", "A", "127.0.0.1"),
+	}
+
+	testCases := map[string]struct {
+		applied []awstypes.Change
+		want    []string
+	}{
+		"nothing applied": {
+			want: []string{"www.example.com A 127.0.0.1", "api.example.com A 127.0.0.1"},
+		},
+		"first batch applied": {
+			applied: []awstypes.Change{
+				change(awstypes.ChangeActionUpsert, "www.example.com."),
+				change(awstypes.ChangeActionCreate, "new1.example.com."),
+				change(awstypes.ChangeActionDelete, "api.example.com."),
+			},
+			want: []string{"www.example.com A 127.0.0.2", "new1.example.com A 127.0.0.1", "api.example.com A 127.0.0.1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testRecordsSummarize(tfroute53.PartialRecords(old, new, testCase.applied, zoneName))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func testRecordsRecord(name, rrType string, values ...string) map[string]interface{} {
+	records := schema.NewSet(schema.HashString, nil)
+	for _, v := range values {
+		records.Add(v)
+	}
+
+	return map[string]interface{}{
+		names.AttrAlias:                    []interface{}{},
+		"failover_routing_policy":          []interface{}{},
+		"health_check_id":                  "",
+		"latency_routing_policy":           []interface{}{},
+		"multivalue_answer_routing_policy": false,
+		names.AttrName:                     name,
+		"records":                          records,
+		"set_identifier":                   "",
+		"ttl":                              300,
+		names.AttrType:                     rrType,
+		"weighted_routing_policy":          []interface{}{},
+	}
+}
+
+func testRecordsRemote(name string, rrType awstypes.RRType, value string) awstypes.ResourceRecordSet {
+	return awstypes.ResourceRecordSet{
+		Name:            aws.String(name),
+		Type:            rrType,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(value)}},
+	}
+}
+
+// testRecordsSummarize returns each record as a "NAME TYPE RECORDS" string.
+func testRecordsSummarize(tfList []interface{}) []string {
+	var got []string
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+		var records []string
+		switch v := tfMap["records"].(type) {
+		case *schema.Set:
+			for _, v := range v.List() {
+				records = append(records, v.(string))
+			}
+		case []string:
+			records = append(records, v...)
+		}
+		slices.Sort(records)
+		got = append(got, fmt.Sprintf("%s %s %s", tfMap[names.AttrName], tfMap[names.AttrType], strings.Join(records, ",")))
+	}
+	return got
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: "www." + zoneName.String(),
+						names.AttrType: "A",
+						"ttl":          "300",
+						"records.#":    acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: "mail." + zoneName.String(),
+						names.AttrType: "MX",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: zoneName.String(),
+						names.AttrType: "TXT",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "v=spf1 -all"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExist(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceRecords(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: "www." + zoneName.String(),
+						names.AttrType: "A",
+						"ttl":          "60",
+						"records.#":    acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: "api." + zoneName.String(),
+						names.AttrType: "CNAME",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						names.AttrName: zoneName.String(),
+						names.AttrType: "TXT",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			for _, key := range testAccRecordsKeys(rs) {
+				_, _, err := tfroute53.FindResourceRecordSetByFourPartKey(ctx, conn, rs.Primary.ID, key[0], key[1], key[2])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Route 53 Record %s %s still exists in Hosted Zone %s", key[0], key[1], rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		for _, key := range testAccRecordsKeys(rs) {
+			if _, _, err := tfroute53.FindResourceRecordSetByFourPartKey(ctx, conn, rs.Primary.ID, key[0], key[1], key[2]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccRecordsKeys returns the name, type and set identifier of each record in state.
+func testAccRecordsKeys(rs *terraform.ResourceState) [][3]string {
+	var keys [][3]string
+
+	for k, v := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "record.") || !strings.HasSuffix(k, "."+names.AttrName) || strings.Count(k, ".") != 2 {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(k, names.AttrName)
+		keys = append(keys, [3]string{v, rs.Primary.Attributes[prefix+names.AttrType], rs.Primary.Attributes[prefix+"set_identifier"]})
+	}
+
+	return keys
+}
+
+func testAccRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["127.0.0.1", "127.0.0.27"]
+  }
+
+  record {
+    name    = "MAIL.%[1]s."
+    type    = "MX"
+    ttl     = 300
+    records = ["10 mx1.%[1]s", "20 mx2.%[1]s"]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 60
+    records = ["127.0.0.1"]
+  }
+
+  record {
+    name    = "api.%[1]s"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.%[1]s"]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
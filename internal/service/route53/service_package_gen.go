@@ -44,6 +44,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_route53_zone",
 			Name:     "Hosted Zone",
 		},
+		{
+			Factory:  dataSourceZoneFile,
+			TypeName: "aws_route53_zone_file",
+			Name:     "Zone File",
+		},
+		{
+			Factory:  dataSourceZoneFileRecords,
+			TypeName: "aws_route53_zone_file_records",
+			Name:     "Zone File Records",
+		},
 	}
 }
 
@@ -83,6 +93,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_route53_record",
 			Name:     "Record",
 		},
+		{
+			Factory:  resourceRecords,
+			TypeName: "aws_route53_records",
+			Name:     "Records",
+		},
 		{
 			Factory:  resourceTrafficPolicy,
 			TypeName: "aws_route53_traffic_policy",
@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// zoneFileRecordSet is a resource record set parsed from an RFC 1035 master (zone) file.
+// Names are fully qualified, lower case and without a trailing period.
+// Values are in the form used by the records argument of aws_route53_record.
+type zoneFileRecordSet struct {
+	name   string
+	rrType string
+	ttl    int64
+	values []string
+}
+
+// key returns the record set's identifier, in the same NAME_TYPE form as aws_route53_record IDs.
+func (rs *zoneFileRecordSet) key() string {
+	return rs.name + "_" + rs.rrType
+}
+
+// parseZoneFile parses the contents of a BIND-style zone file into record sets.
+// Records with the same name and type are merged into a single record set, in order of first appearance.
+// The $ORIGIN and $TTL directives are supported; $INCLUDE and $GENERATE are not.
+func parseZoneFile(content, origin string, defaultTTL int64) ([]*zoneFileRecordSet, error) {
+	origin = fqdn(strings.ToLower(origin))
+	var recordSets []*zoneFileRecordSet
+	byKey := make(map[string]*zoneFileRecordSet)
+	var lastOwner string
+	var lastTTL int64 = -1
+
+	entries, err := zoneFileEntries(content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		fields := entry.fields
+
+		if directive := strings.ToUpper(fields[0].text); strings.HasPrefix(directive, "$") {
+			switch directive {
+			case "$ORIGIN":
+				if len(fields) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires exactly one domain name", entry.line)
+				}
+				origin = qualifyZoneFileName(fields[1].text, origin)
+			case "$TTL":
+				if len(fields) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires exactly one value", entry.line)
+				}
+				ttl, err := parseZoneFileTTL(fields[1].text)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", entry.line, err)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", entry.line, fields[0].text)
+			}
+
+			continue
+		}
+
+		// <owner> [<TTL>] [<class>] <type> <RDATA>, where TTL and class may appear in either order.
+		// A blank owner means the previous owner.
+		var owner string
+		if entry.blankOwner {
+			if lastOwner == "" {
+				return nil, fmt.Errorf("line %d: no previous owner name", entry.line)
+			}
+			owner = lastOwner
+		} else {
+			if origin == "" && (fields[0].text == "@" || !strings.HasSuffix(fields[0].text, ".")) {
+				return nil, fmt.Errorf("line %d: relative name %q with no origin", entry.line, fields[0].text)
+			}
+			owner = qualifyZoneFileName(fields[0].text, origin)
+			fields = fields[1:]
+		}
+		lastOwner = owner
+
+		ttl := int64(-1)
+		for len(fields) > 0 {
+			if v := strings.ToUpper(fields[0].text); v == "IN" {
+				fields = fields[1:]
+				continue
+			} else if slices.Contains([]string{"CH", "CS", "HS"}, v) {
+				return nil, fmt.Errorf("line %d: unsupported class %s", entry.line, fields[0].text)
+			}
+			if v, err := parseZoneFileTTL(fields[0].text); err == nil {
+				ttl = v
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("line %d: missing record type", entry.line)
+		}
+
+		rrType := strings.ToUpper(fields[0].text)
+		if !slices.Contains(enum.Values[awstypes.RRType](), rrType) {
+			return nil, fmt.Errorf("line %d: unsupported record type %s", entry.line, fields[0].text)
+		}
+		rdata := fields[1:]
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("line %d: missing %s record data", entry.line, rrType)
+		}
+
+		switch {
+		case ttl >= 0:
+			lastTTL = ttl
+		case defaultTTL >= 0:
+			ttl = defaultTTL
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: no TTL specified and no default TTL", entry.line)
+		}
+
+		value, err := zoneFileRecordValue(awstypes.RRType(rrType), rdata, origin)
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", entry.line, err)
+		}
+
+		name := normalizeZoneName(unescapeZoneFileName(owner))
+		key := name + "_" + rrType
+		rs, ok := byKey[key]
+		if !ok {
+			rs = &zoneFileRecordSet{
+				name:   name,
+				rrType: rrType,
+				ttl:    ttl,
+			}
+			byKey[key] = rs
+			recordSets = append(recordSets, rs)
+		}
+		if !slices.Contains(rs.values, value) {
+			rs.values = append(rs.values, value)
+		}
+	}
+
+	return recordSets, nil
+}
+
+// zoneFileRecordValue returns the record data in the form used by aws_route53_record.
+// Domain names in the record data are fully qualified.
+func zoneFileRecordValue(rrType awstypes.RRType, rdata []zoneFileField, origin string) (string, error) {
+	texts := make([]string, len(rdata))
+	for i, v := range rdata {
+		texts[i] = v.text
+	}
+
+	// Index of the domain name fields that must be qualified.
+	var names []int
+	switch rrType {
+	case awstypes.RRTypeCname, awstypes.RRTypeNs, awstypes.RRTypePtr:
+		names = []int{0}
+	case awstypes.RRTypeMx:
+		names = []int{1}
+	case awstypes.RRTypeSrv:
+		names = []int{3}
+	case awstypes.RRTypeSoa:
+		names = []int{0, 1}
+	case awstypes.RRTypeTxt, awstypes.RRTypeSpf:
+		// Character strings keep their zone file escapes, which Route 53 also uses.
+		quoted := make([]string, len(rdata))
+		for i, v := range rdata {
+			quoted[i] = `"` + v.text + `"`
+		}
+		return expandTxtEntry(strings.Join(quoted, " ")), nil
+	case awstypes.RRTypeCaa:
+		if len(rdata) != 3 {
+			return "", fmt.Errorf("CAA record data must have 3 fields, got %d", len(rdata))
+		}
+		return fmt.Sprintf(`%s %s "%s"`, texts[0], texts[1], texts[2]), nil
+	}
+
+	for _, i := range names {
+		if i >= len(texts) {
+			return "", fmt.Errorf("%s record data must have at least %d fields, got %d", rrType, i+1, len(texts))
+		}
+		texts[i] = qualifyZoneFileName(texts[i], origin)
+	}
+
+	return strings.Join(texts, " "), nil
+}
+
+// qualifyZoneFileName returns the fully qualified form (with trailing period) of a name from a zone file.
+func qualifyZoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	case origin == "" || origin == ".":
+		return strings.ToLower(name) + "."
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// unescapeZoneFileName decodes the \X and \DDD (decimal) escapes of a zone file domain name.
+func unescapeZoneFileName(name string) string {
+	if !strings.Contains(name, `\`) {
+		return name
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] != '\\' || i+1 == len(name) {
+			sb.WriteByte(name[i])
+			continue
+		}
+
+		if i+3 < len(name) {
+			if v, err := strconv.ParseUint(name[i+1:i+4], 10, 8); err == nil {
+				sb.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+
+		sb.WriteByte(name[i+1])
+		i++
+	}
+
+	return sb.String()
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in BIND's unit form, e.g. "1h30m" or "1W".
+func parseZoneFileTTL(s string) (int64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if v < 0 {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		return v, nil
+	}
+
+	var total, n int64
+	var digits bool
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsDigit(r) {
+			n = n*10 + int64(r-'0')
+			digits = true
+			continue
+		}
+
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+
+		switch r {
+		case 's':
+		case 'm':
+			n *= 60
+		case 'h':
+			n *= 60 * 60
+		case 'd':
+			n *= 24 * 60 * 60
+		case 'w':
+			n *= 7 * 24 * 60 * 60
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+
+		total += n
+		n, digits = 0, false
+	}
+
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return total, nil
+}
+
+type zoneFileField struct {
+	quoted bool
+	text   string
+}
+
+type zoneFileEntry struct {
+	blankOwner bool
+	fields     []zoneFileField
+	line       int
+}
+
+// zoneFileEntries splits zone file contents into entries, handling comments, quoted strings and
+// parentheses that continue an entry over several lines.
+func zoneFileEntries(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var current *zoneFileEntry
+	var field strings.Builder
+	var inField, inQuotes, escaped bool
+	depth, line := 0, 1
+
+	endField := func(quoted bool) {
+		if inField || quoted {
+			current.fields = append(current.fields, zoneFileField{quoted: quoted, text: field.String()})
+		}
+		field.Reset()
+		inField = false
+	}
+	endEntry := func() {
+		if current != nil && len(current.fields) > 0 {
+			entries = append(entries, *current)
+		}
+		current = nil
+	}
+
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if current == nil {
+			current = &zoneFileEntry{
+				blankOwner: r == ' ' || r == '\t',
+				line:       line,
+			}
+		}
+
+		switch {
+		case inQuotes:
+			switch {
+			case escaped:
+				field.WriteRune(r)
+				escaped = false
+			case r == '\\':
+				field.WriteRune(r)
+				escaped = true
+			case r == '"':
+				inQuotes = false
+				endField(true)
+			default:
+				if r == '\n' {
+					line++
+				}
+				field.WriteRune(r)
+			}
+		case escaped:
+			field.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			field.WriteRune(r)
+			inField, escaped = true, true
+		case r == '"':
+			endField(false)
+			inQuotes = true
+		case r == ';':
+			endField(false)
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == '(':
+			endField(false)
+			depth++
+		case r == ')':
+			endField(false)
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			depth--
+		case r == '\n':
+			endField(false)
+			line++
+			if depth == 0 {
+				endEntry()
+			}
+		case r == ' ' || r == '\t' || r == '\r':
+			endField(false)
+		default:
+			field.WriteRune(r)
+			inField = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+	if current != nil {
+		endField(false)
+		endEntry()
+	}
+
+	return entries, nil
+}
+
+// renderZoneFile renders a hosted zone's record sets as an RFC 1035 master (zone) file.
+// Alias records and records with a routing policy have no zone file representation
+// and are rendered as comments.
+func renderZoneFile(zoneName string, recordSets []awstypes.ResourceRecordSet) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "$ORIGIN %s\n", fqdn(strings.ToLower(zoneName)))
+
+	for _, rs := range recordSets {
+		name := zoneFileName(fqdn(cleanRecordName(aws.ToString(rs.Name))))
+
+		if v := rs.AliasTarget; v != nil {
+			fmt.Fprintf(&sb, "; %s\tALIAS\t%s\t%s\n", name, rs.Type, aws.ToString(v.DNSName))
+			continue
+		}
+
+		var prefix string
+		if v := aws.ToString(rs.SetIdentifier); v != "" {
+			fmt.Fprintf(&sb, "; Record set %q has a routing policy:\n", v)
+			prefix = "; "
+		}
+
+		for _, rr := range rs.ResourceRecords {
+			fmt.Fprintf(&sb, "%s%s\t%d\tIN\t%s\t%s\n", prefix, name, aws.ToInt64(rs.TTL), rs.Type, aws.ToString(rr.Value))
+		}
+	}
+
+	return sb.String()
+}
+
+// zoneFileName escapes the characters of a domain name that are special in a zone file.
+func zoneFileName(name string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; {
+		case c <= ' ' || c >= 0x7f:
+			fmt.Fprintf(&sb, `\%03d`, c)
+		case strings.IndexByte(`"();\`, c) >= 0:
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, zoneName.String()),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexp.MustCompile(fmt.Sprintf(`(?m)^\$ORIGIN %s\.$`, regexp.QuoteMeta(zoneName.String())))),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexp.MustCompile(fmt.Sprintf(`(?m)^www\.%s\.\t300\tIN\tA\t127\.0\.0\.1$`, regexp.QuoteMeta(zoneName.String())))),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexp.MustCompile(`(?m)\tIN\tSOA\t`)),
+					// The rendered zone file round-trips through the parser.
+					resource.TestCheckResourceAttr("data.aws_route53_zone_file_records.test", "record.#", acctest.Ct1),
+					resource.TestCheckResourceAttr("data.aws_route53_zone_file_records.test", "record.0.name", "www."+zoneName.String()),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileDataSourceConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["127.0.0.1"]
+}
+
+data "aws_route53_zone_file" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.test]
+}
+
+data "aws_route53_zone_file_records" "test" {
+  content = data.aws_route53_zone_file.test.content
+}
+`, zoneName)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"strconv"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_route53_zone_file_records", name="Zone File Records")
+func dataSourceZoneFileRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceZoneFileRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrContent: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"include_apex_ns_soa": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"record": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZoneFileRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content := d.Get(names.AttrContent).(string)
+	origin := d.Get("origin").(string)
+	defaultTTL := int64(-1)
+	if v := d.GetRawConfig().GetAttr("default_ttl"); !v.IsNull() {
+		defaultTTL = int64(d.Get("default_ttl").(int))
+	}
+
+	recordSets, err := parseZoneFile(content, origin, defaultTTL)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing zone file: %s", err)
+	}
+
+	// Route 53 manages the SOA and NS records at the zone apex itself.
+	var apex string
+	if !d.Get("include_apex_ns_soa").(bool) {
+		apex = normalizeZoneName(origin)
+		for _, rs := range recordSets {
+			if rs.rrType == string(awstypes.RRTypeSoa) {
+				apex = rs.name
+				break
+			}
+		}
+	}
+
+	tfList := make([]interface{}, 0, len(recordSets))
+	for _, rs := range recordSets {
+		if apex != "" && rs.name == apex && (rs.rrType == string(awstypes.RRTypeSoa) || rs.rrType == string(awstypes.RRTypeNs)) {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKey:  rs.key(),
+			names.AttrName: rs.name,
+			"records":      rs.values,
+			"ttl":          rs.ttl,
+			names.AttrType: rs.rrType,
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(content)))
+	if err := d.Set("record", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+
+	return diags
+}
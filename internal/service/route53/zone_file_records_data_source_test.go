@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileRecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileRecordsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "record.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "record.0.key", "www.example.com_A"),
+					resource.TestCheckResourceAttr(dataSourceName, "record.0.name", "www.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "record.0.records.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "record.0.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "record.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "record.1.key", "mail.example.com_MX"),
+					resource.TestCheckResourceAttr(dataSourceName, "record.1.records.0", "10 mx.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "record.1.ttl", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneFileRecordsDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneFileRecordsDataSourceConfig_invalid,
+				ExpectError: regexp.MustCompile(`line 2: no TTL specified and no default TTL`),
+			},
+		},
+	})
+}
+
+const testAccZoneFileRecordsDataSourceConfig_basic = `
+data "aws_route53_zone_file_records" "test" {
+  content = <<-EOT
+    $ORIGIN example.com.
+    $TTL 1h
+    @    IN SOA ns1 hostmaster 1 7200 3600 1209600 300
+    @    IN NS  ns1
+    www  300 IN A  192.0.2.1
+         300 IN A  192.0.2.2
+    mail IN MX 10 mx
+  EOT
+}
+`
+
+const testAccZoneFileRecordsDataSourceConfig_invalid = `
+data "aws_route53_zone_file_records" "test" {
+  content = <<-EOT
+    $ORIGIN example.com.
+    www IN A 192.0.2.1
+  EOT
+}
+`
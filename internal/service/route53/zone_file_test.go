@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content     string
+		origin      string
+		defaultTTL  int64
+		want        []*zoneFileRecordSet
+		expectError bool
+	}{
+		"empty": {
+			defaultTTL: -1,
+		},
+		"basic": {
+			content: `
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+		2024010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		300 )      ; minimum
+	IN	NS	ns1
+www	300	IN	A	192.0.2.1
+WWW	IN	300	A	192.0.2.2
+mail	IN	MX	10 mx1.example.net.
+*.dev	CNAME	www
+@	TXT	"v=spf1 -all"
+`,
+			defaultTTL: -1,
+			want: []*zoneFileRecordSet{
+				{name: "example.com", rrType: "SOA", ttl: 3600, values: []string{"ns1.example.com. hostmaster.example.com. 2024010101 7200 3600 1209600 300"}},
+				{name: "example.com", rrType: "NS", ttl: 3600, values: []string{"ns1.example.com."}},
+				{name: "www.example.com", rrType: "A", ttl: 300, values: []string{"192.0.2.1", "192.0.2.2"}},
+				{name: "mail.example.com", rrType: "MX", ttl: 3600, values: []string{"10 mx1.example.net."}},
+				{name: "*.dev.example.com", rrType: "CNAME", ttl: 3600, values: []string{"www.example.com."}},
+				{name: "example.com", rrType: "TXT", ttl: 3600, values: []string{"v=spf1 -all"}},
+			},
+		},
+		"origin argument": {
+			content:    "api 60 IN AAAA 2001:db8::1\n",
+			origin:     "Example.com",
+			defaultTTL: -1,
+			want: []*zoneFileRecordSet{
+				{name: "api.example.com", rrType: "AAAA", ttl: 60, values: []string{"2001:db8::1"}},
+			},
+		},
+		"default TTL argument": {
+			content:    "api.example.com. IN A 192.0.2.1\n",
+			defaultTTL: 120,
+			want: []*zoneFileRecordSet{
+				{name: "api.example.com", rrType: "A", ttl: 120, values: []string{"192.0.2.1"}},
+			},
+		},
+		"previous TTL": {
+			content:    "a.example.com. 60 IN A 192.0.2.1\nb.example.com. IN A 192.0.2.2\n",
+			defaultTTL: -1,
+			want: []*zoneFileRecordSet{
+				{name: "a.example.com", rrType: "A", ttl: 60, values: []string{"192.0.2.1"}},
+				{name: "b.example.com", rrType: "A", ttl: 60, values: []string{"192.0.2.2"}},
+			},
+		},
+		"TXT multiple strings": {
+			content:    `txt.example.com. 300 IN TXT "abc" "def;ghi"` + "\n",
+			defaultTTL: -1,
+			want: []*zoneFileRecordSet{
+				{name: "txt.example.com", rrType: "TXT", ttl: 300, values: []string{`abc" "def;ghi`}},
+			},
+		},
+		"CAA": {
+			content:    `example.com. 300 IN CAA 0 issue "amazon.com"` + "\n",
+			defaultTTL: -1,
+			want: []*zoneFileRecordSet{
+				{name: "example.com", rrType: "CAA", ttl: 300, values: []string{`0 issue "amazon.com"`}},
+			},
+		},
+		"escaped name": {
+			content:    `\042quoted.example.com. 300 IN A 192.0.2.1` + "\n",
+			defaultTTL: -1,
+			want: []*zoneFileRecordSet{
+				{name: `*quoted.example.com`, rrType: "A", ttl: 300, values: []string{"192.0.2.1"}},
+			},
+		},
+		"relative name without origin": {
+			content:     "www 300 IN A 192.0.2.1\n",
+			defaultTTL:  -1,
+			expectError: true,
+		},
+		"no TTL": {
+			content:     "www.example.com. IN A 192.0.2.1\n",
+			defaultTTL:  -1,
+			expectError: true,
+		},
+		"unsupported type": {
+			content:     "www.example.com. 300 IN HINFO PC Linux\n",
+			defaultTTL:  -1,
+			expectError: true,
+		},
+		"unsupported directive": {
+			content:     "$INCLUDE other.zone\n",
+			defaultTTL:  -1,
+			expectError: true,
+		},
+		"unbalanced parentheses": {
+			content:     "www.example.com. 300 IN A ( 192.0.2.1\n",
+			defaultTTL:  -1,
+			expectError: true,
+		},
+		"unterminated string": {
+			content:     `www.example.com. 300 IN TXT "abc` + "\n",
+			defaultTTL:  -1,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFile(testCase.content, testCase.origin, testCase.defaultTTL)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(zoneFileRecordSet{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		want        int64
+		expectError bool
+	}{
+		"seconds":    {input: "300", want: 300},
+		"units":      {input: "1h30m", want: 5400},
+		"upper case": {input: "1W2D", want: 777600},
+		"empty":      {input: "", expectError: true},
+		"negative":   {input: "-1", expectError: true},
+		"no unit":    {input: "1h30", expectError: true},
+		"bad unit":   {input: "1y", expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFileTTL(testCase.input)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %d, want %d", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	t.Parallel()
+
+	recordSets := []awstypes.ResourceRecordSet{
+		{
+			Name:            aws.String("example.com."),
+			Type:            awstypes.RRTypeTxt,
+			TTL:             aws.Int64(300),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(`"v=spf1 -all"`)}},
+		},
+		{
+			Name:            aws.String(`\052.example.com.`),
+			Type:            awstypes.RRTypeA,
+			TTL:             aws.Int64(60),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}, {Value: aws.String("192.0.2.2")}},
+		},
+		{
+			Name:            aws.String("weighted.example.com."),
+			Type:            awstypes.RRTypeCname,
+			TTL:             aws.Int64(60),
+			SetIdentifier:   aws.String("blue"),
+			Weight:          aws.Int64(10),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("blue.example.com.")}},
+		},
+		{
+			Name:        aws.String("alias.example.com."),
+			Type:        awstypes.RRTypeA,
+			AliasTarget: &awstypes.AliasTarget{DNSName: aws.String("lb.example.net.")},
+		},
+	}
+
+	got := renderZoneFile("Example.com.", recordSets)
+	want := `$ORIGIN example.com.
+example.com.	300	IN	TXT	"v=spf1 -all"
+*.example.com.	60	IN	A	192.0.2.1
+*.example.com.	60	IN	A	192.0.2.2
+; Record set "blue" has a routing policy:
+; weighted.example.com.	60	IN	CNAME	blue.example.com.
+; alias.example.com.	ALIAS	A	lb.example.net.
+`
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// The rendered zone file parses back to the plain record sets.
+	parsed, err := parseZoneFile(got, "", -1)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(parsed), 2; got != want {
+		t.Errorf("parsed %d record sets, want %d", got, want)
+	}
+}
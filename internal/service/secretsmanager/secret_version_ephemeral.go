@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_secretsmanager_secret_version", name="Secret Version")
+func newSecretVersionEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	r := &secretVersionEphemeralResource{}
+
+	return r, nil
+}
+
+type secretVersionEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (r *secretVersionEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_secretsmanager_secret_version"
+}
+
+func (r *secretVersionEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedDate: schema.StringAttribute{
+				Computed: true,
+			},
+			"secret_binary": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_id": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"version_stage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"version_stages": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *secretVersionEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data secretVersionEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecretsManagerClient(ctx)
+
+	secretID := data.SecretID.ValueString()
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	}
+
+	var version string
+	if v := data.VersionID.ValueString(); v != "" {
+		input.VersionId = aws.String(v)
+		version = v
+	} else {
+		v := data.VersionStage.ValueString()
+		if v == "" {
+			v = secretVersionStageCurrent
+		}
+		input.VersionStage = aws.String(v)
+		version = v
+	}
+
+	output, err := findSecretVersion(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Secrets Manager Secret Version (%s)", secretVersionCreateResourceID(secretID, version)), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.ARN)
+	data.CreatedDate = types.StringValue(aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	data.SecretBinary = types.StringValue(string(output.SecretBinary))
+	data.SecretString = flex.StringToFramework(ctx, output.SecretString)
+	data.VersionID = flex.StringToFramework(ctx, output.VersionId)
+	data.VersionStage = flex.StringToFramework(ctx, input.VersionStage)
+	data.VersionStages = flex.FlattenFrameworkStringValueList(ctx, output.VersionStages)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type secretVersionEphemeralResourceModel struct {
+	ARN           types.String `tfsdk:"arn"`
+	CreatedDate   types.String `tfsdk:"created_date"`
+	SecretBinary  types.String `tfsdk:"secret_binary"`
+	SecretID      types.String `tfsdk:"secret_id"`
+	SecretString  types.String `tfsdk:"secret_string"`
+	VersionID     types.String `tfsdk:"version_id"`
+	VersionStage  types.String `tfsdk:"version_stage"`
+	VersionStages types.List   `tfsdk:"version_stages"`
+}
@@ -572,7 +572,10 @@ type dataLakeReplicationConfigurationModel struct {
 }
 
 func retryDataLakeConflictWithMutex[T any](ctx context.Context, f func() (T, error)) (T, error) {
-	conns.GlobalMutexKV.Lock(dataLakeMutexKey)
+	if err := conns.GlobalMutexKV.LockContext(ctx, dataLakeMutexKey); err != nil {
+		var zero T
+		return zero, err
+	}
 	defer conns.GlobalMutexKV.Unlock(dataLakeMutexKey)
 
 	const dataLakeTimeout = 2 * time.Minute
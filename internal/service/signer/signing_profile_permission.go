@@ -93,7 +93,9 @@ func resourceSigningProfilePermissionCreate(ctx context.Context, d *schema.Resou
 
 	profileName := d.Get("profile_name").(string)
 
-	conns.GlobalMutexKV.Lock(profileName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, profileName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding Signer Signing Profile (%s) Permission: %s", profileName, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(profileName)
 
 	var revisionID string
@@ -184,7 +186,9 @@ func resourceSigningProfilePermissionDelete(ctx context.Context, d *schema.Resou
 
 	profileName := d.Get("profile_name").(string)
 
-	conns.GlobalMutexKV.Lock(profileName)
+	if err := conns.GlobalMutexKV.LockContext(ctx, profileName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Signer Signing Profile Permission (%s): %s", d.Id(), err)
+	}
 	defer conns.GlobalMutexKV.Unlock(profileName)
 
 	output, err := conn.ListProfilePermissions(ctx, &signer.ListProfilePermissionsInput{
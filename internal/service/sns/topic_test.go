@@ -37,6 +37,7 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 
 func TestAccSNSTopic_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.OfflineCapable(t)
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
 
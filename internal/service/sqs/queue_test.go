@@ -95,6 +95,7 @@ func TestQueueNameFromURL(t *testing.T) {
 
 func TestAccSQSQueue_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.OfflineCapable(t)
 	var queueAttributes map[types.QueueAttributeName]string
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ssm_parameter", name="Parameter")
+func newParameterEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	r := &parameterEphemeralResource{}
+
+	return r, nil
+}
+
+type parameterEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (r *parameterEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (r *parameterEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Computed: true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *parameterEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data parameterEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	// Ephemeral resource schemas do not support default values.
+	if data.WithDecryption.IsNull() || data.WithDecryption.IsUnknown() {
+		data.WithDecryption = types.BoolValue(true)
+	}
+
+	name := data.Name.ValueString()
+	param, err := findParameterByName(ctx, conn, name, data.WithDecryption.ValueBool())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameter (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, param.ARN)
+	data.Name = flex.StringToFramework(ctx, param.Name)
+	data.Type = types.StringValue(string(param.Type))
+	data.Value = flex.StringToFramework(ctx, param.Value)
+	data.Version = types.Int64Value(param.Version)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type parameterEphemeralResourceModel struct {
+	ARN            types.String `tfsdk:"arn"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	Version        types.Int64  `tfsdk:"version"`
+	WithDecryption types.Bool   `tfsdk:"with_decryption"`
+}
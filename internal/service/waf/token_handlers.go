@@ -24,7 +24,9 @@ func (t *retryer) RetryWithToken(ctx context.Context, f withTokenFunc) (interfac
 	const (
 		key = "WafRetryer"
 	)
-	conns.GlobalMutexKV.Lock(key)
+	if err := conns.GlobalMutexKV.LockContext(ctx, key); err != nil {
+		return nil, err
+	}
 	defer conns.GlobalMutexKV.Unlock(key)
 
 	const (
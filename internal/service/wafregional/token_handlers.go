@@ -23,7 +23,9 @@ type withTokenFunc func(token *string) (interface{}, error)
 
 func (t *retryer) RetryWithToken(ctx context.Context, f withTokenFunc) (interface{}, error) {
 	key := "WafRetryer-" + t.region
-	conns.GlobalMutexKV.Lock(key)
+	if err := conns.GlobalMutexKV.LockContext(ctx, key); err != nil {
+		return nil, err
+	}
 	defer conns.GlobalMutexKV.Unlock(key)
 
 	const (
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+var (
+	flagSweepDryRun       = flag.Bool("sweep-dry-run", false, "List the resources that Sweepers would delete without deleting them")
+	flagSweepKeepTags     = flag.String("sweep-keep-tags", "", "Comma-separated list of tags, as key=value or key, whose resources Sweepers keep")
+	flagSweepNamePrefixes = flag.String("sweep-name-prefixes", "", "Comma-separated list of name prefixes. Sweepers only delete resources whose names start with one of them")
+	flagSweepMinAge       = flag.Duration("sweep-min-age", 0, "Sweepers only delete resources created at least this long ago")
+)
+
+// errNotOrchestrated is returned when a Sweeper modifies resources other than via the sweep orchestrator
+// in dry-run mode or when a filter is configured.
+var errNotOrchestrated = errors.New("sweeper modifies resources without using the sweep orchestrator, which is not supported in dry-run mode or with filters")
+
+// errNotDescribable is returned when a filter is configured and a Sweeper returns a Sweepable that is not Describable.
+var errNotDescribable = errors.New("sweeper returns resources that cannot be described, which is not supported with filters")
+
+// Describable is implemented by Sweepables that can describe the resource that they delete.
+// Only resources that can be described can be filtered.
+type Describable interface {
+	// Describe returns a description of the resource, or nil if the resource no longer exists.
+	Describe(ctx context.Context) (*filter.Resource, error)
+}
+
+// sweepFilter returns whether Sweepers are running in dry-run mode and the filter applied to swept resources.
+// Flags take precedence over environment variables.
+func sweepFilter() (bool, filter.Filter, error) {
+	var f filter.Filter
+
+	dryRun := *flagSweepDryRun
+	if v := os.Getenv(envvar.SweepDryRun); !dryRun && v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, f, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		dryRun = b
+	}
+
+	keepTags := *flagSweepKeepTags
+	if keepTags == "" {
+		keepTags = os.Getenv(envvar.SweepKeepTags)
+	}
+	tags, err := filter.ParseTags(keepTags)
+	if err != nil {
+		return false, f, fmt.Errorf("keep tags: %w", err)
+	}
+	f.KeepTags = tags
+
+	namePrefixes := *flagSweepNamePrefixes
+	if namePrefixes == "" {
+		namePrefixes = os.Getenv(envvar.SweepNamePrefixes)
+	}
+	f.NamePrefixes = filter.ParseNamePrefixes(namePrefixes)
+
+	f.MinAge = *flagSweepMinAge
+	if v := os.Getenv(envvar.SweepMinAge); f.MinAge == 0 && v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return false, f, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		f.MinAge = d
+	}
+
+	return dryRun, f, nil
+}
+
+// keepSweepable returns whether the specified Sweepable's resource is kept, rather than deleted.
+// The returned Context is enhanced with any description of the resource.
+// Sweepables that are not Describable can't be filtered, so an error is returned if a filter is configured.
+func keepSweepable(ctx context.Context, sweepable Sweepable, f filter.Filter) (context.Context, bool, error) {
+	v, ok := sweepable.(Describable)
+	if !ok {
+		if f.IsEmpty() {
+			return ctx, false, nil
+		}
+
+		return ctx, true, fmt.Errorf("%T: %w", sweepable, errNotDescribable)
+	}
+
+	r, err := v.Describe(ctx)
+	if err != nil {
+		return ctx, true, fmt.Errorf("describing resource: %w", err)
+	}
+
+	if r == nil {
+		tflog.Debug(ctx, "Resource no longer exists")
+		return ctx, true, nil
+	}
+
+	ctx = tflog.SetField(ctx, "id", r.ID)
+	if r.Name != "" {
+		ctx = tflog.SetField(ctx, "name", r.Name)
+	}
+
+	if keep, reason := f.Keep(*r, time.Now()); keep {
+		tflog.Info(ctx, "Keeping resource", map[string]any{
+			"reason": reason,
+		})
+		return ctx, true, nil
+	}
+
+	return ctx, false, nil
+}
+
+// orchestratorDeleteContextKey marks the Context of a resource deletion made by the sweep orchestrator
+// after the resource has passed the configured filter.
+type orchestratorDeleteContextKey struct{}
+
+// readOnlyOperationPrefixes are the name prefixes of AWS API operations that don't modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// apiCallGuard returns the AWS API call guard for Sweepers, or nil if none is needed.
+// In dry-run mode, or when a filter is configured, only read-only operations are allowed
+// outside of resource deletions made by the sweep orchestrator.
+// Sweepers that delete resources directly can't honor dry-run mode or the filter, so their deletions fail
+// instead of deleting resources that should be kept.
+func apiCallGuard(dryRun bool, f filter.Filter) conns.APICallGuard {
+	if !dryRun && f.IsEmpty() {
+		return nil
+	}
+
+	return func(ctx context.Context, operation string) error {
+		if ctx.Value(orchestratorDeleteContextKey{}) != nil {
+			return nil
+		}
+
+		for _, prefix := range readOnlyOperationPrefixes {
+			if strings.HasPrefix(operation, prefix) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("AWS API operation %s: %w", operation, errNotOrchestrated)
+	}
+}
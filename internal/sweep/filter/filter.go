@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// CreationTimeAttributes are the names of resource attributes that may hold a resource's creation time.
+var CreationTimeAttributes = []string{
+	names.AttrCreateTime,
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// Resource describes a resource that is a candidate for sweeping.
+type Resource struct {
+	ID        string
+	Name      string            // Empty if unknown.
+	Tags      map[string]string // Nil if unknown.
+	CreatedAt time.Time         // Zero if unknown.
+}
+
+// Filter determines which resources are kept, rather than deleted, by sweepers.
+// Resources are kept if they have any of KeepTags, if NamePrefixes is set and their name does not start with any of the prefixes,
+// or if MinAge is set and they were created more recently than MinAge ago.
+// Resources are also kept if the information required to apply a filter is unknown.
+type Filter struct {
+	KeepTags     map[string]string // Tag key -> value. An empty value matches any value.
+	NamePrefixes []string
+	MinAge       time.Duration
+}
+
+// IsEmpty returns whether the filter keeps no resources.
+func (f Filter) IsEmpty() bool {
+	return len(f.KeepTags) == 0 && len(f.NamePrefixes) == 0 && f.MinAge == 0
+}
+
+// Keep returns whether the specified resource is kept and, if so, why.
+func (f Filter) Keep(r Resource, now time.Time) (bool, string) {
+	if len(f.KeepTags) > 0 {
+		if r.Tags == nil {
+			return true, "tags unknown"
+		}
+		for k, v := range f.KeepTags {
+			if tag, ok := r.Tags[k]; ok && (v == "" || tag == v) {
+				return true, fmt.Sprintf("tagged %s=%s", k, tag)
+			}
+		}
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		if r.Name == "" {
+			return true, "name unknown"
+		}
+		if !hasAnyPrefix(r.Name, f.NamePrefixes) {
+			return true, "name does not match prefixes"
+		}
+	}
+
+	if f.MinAge > 0 {
+		if r.CreatedAt.IsZero() {
+			return true, "creation time unknown"
+		}
+		if age := now.Sub(r.CreatedAt); age < f.MinAge {
+			return true, fmt.Sprintf("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	return false, ""
+}
+
+// ParseTags parses a comma-separated list of tags, each either key=value or key.
+func ParseTags(s string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(v, "=")
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag (%s): empty key", v)
+		}
+		tags[key] = value
+	}
+
+	return tags, nil
+}
+
+// ParseNamePrefixes parses a comma-separated list of name prefixes.
+func ParseNamePrefixes(s string) []string {
+	var prefixes []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			prefixes = append(prefixes, v)
+		}
+	}
+
+	return prefixes
+}
+
+// ParseTime parses a resource creation time attribute value.
+// Both RFC 3339 timestamps and Unix epoch seconds are accepted.
+func ParseTime(s string) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true
+	}
+
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil && seconds > 0 {
+		return time.Unix(int64(seconds), 0), true
+	}
+
+	return time.Time{}, false
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
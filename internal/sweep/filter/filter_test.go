@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"maps"
+	"testing"
+	"time"
+)
+
+func TestFilterKeep(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter   Filter
+		resource Resource
+		expected bool
+	}{
+		"empty filter": {
+			resource: Resource{ID: "r-1"},
+		},
+		"keep tag key and value": {
+			filter:   Filter{KeepTags: map[string]string{"keep": "true"}},
+			resource: Resource{ID: "r-1", Tags: map[string]string{"keep": "true"}},
+			expected: true,
+		},
+		"keep tag other value": {
+			filter:   Filter{KeepTags: map[string]string{"keep": "true"}},
+			resource: Resource{ID: "r-1", Tags: map[string]string{"keep": "false"}},
+		},
+		"keep tag any value": {
+			filter:   Filter{KeepTags: map[string]string{"owner": ""}},
+			resource: Resource{ID: "r-1", Tags: map[string]string{"owner": "team"}},
+			expected: true,
+		},
+		"keep tag unknown tags": {
+			filter:   Filter{KeepTags: map[string]string{"keep": "true"}},
+			resource: Resource{ID: "r-1"},
+			expected: true,
+		},
+		"name prefix match": {
+			filter:   Filter{NamePrefixes: []string{"tf-acc-test", "tf-test"}},
+			resource: Resource{ID: "r-1", Name: "tf-test-123"},
+		},
+		"name prefix no match": {
+			filter:   Filter{NamePrefixes: []string{"tf-acc-test"}},
+			resource: Resource{ID: "r-1", Name: "production"},
+			expected: true,
+		},
+		"name prefix unknown name": {
+			filter:   Filter{NamePrefixes: []string{"tf-acc-test"}},
+			resource: Resource{ID: "r-1"},
+			expected: true,
+		},
+		"min age old": {
+			filter:   Filter{MinAge: time.Hour},
+			resource: Resource{ID: "r-1", CreatedAt: now.Add(-2 * time.Hour)},
+		},
+		"min age new": {
+			filter:   Filter{MinAge: time.Hour},
+			resource: Resource{ID: "r-1", CreatedAt: now.Add(-30 * time.Minute)},
+			expected: true,
+		},
+		"min age unknown creation time": {
+			filter:   Filter{MinAge: time.Hour},
+			resource: Resource{ID: "r-1"},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filter.Keep(testCase.resource, now)
+
+			if got != testCase.expected {
+				t.Errorf("Keep() = %t (%s), want %t", got, reason, testCase.expected)
+			}
+			if got && reason == "" {
+				t.Error("Keep() returned no reason")
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    map[string]string
+		expectError bool
+	}{
+		"empty": {
+			expected: map[string]string{},
+		},
+		"key and value": {
+			input:    "keep=true",
+			expected: map[string]string{"keep": "true"},
+		},
+		"multiple": {
+			input:    "keep=true, owner ,env=dev",
+			expected: map[string]string{"keep": "true", "owner": "", "env": "dev"},
+		},
+		"empty key": {
+			input:       "=true",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTags(testCase.input)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParseTags() err %t, want %t", got, want)
+			}
+			if err == nil && !maps.Equal(got, testCase.expected) {
+				t.Errorf("ParseTags() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected time.Time
+		ok       bool
+	}{
+		"RFC3339": {
+			input:    "2024-06-01T12:00:00Z",
+			expected: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		"epoch seconds": {
+			input:    "1717243200",
+			expected: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		"date only": {
+			input: "2024-06-01",
+		},
+		"empty": {},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ParseTime(testCase.input)
+
+			if ok != testCase.ok {
+				t.Fatalf("ParseTime() ok = %t, want %t", ok, testCase.ok)
+			}
+			if !got.Equal(testCase.expected) {
+				t.Errorf("ParseTime() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type resourceTypeContextKey struct{}
+
+// NewResourceTypeContext returns a Context that carries the type name, for example aws_sqs_queue, of the resources being swept.
+func NewResourceTypeContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, typeName)
+}
+
+// ResourceTypeFromContext returns the type name of the resources being swept, if known.
+func ResourceTypeFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(resourceTypeContextKey{}).(string)
+	return v, ok && v != ""
+}
+
+// taggedResourceType is a resource type and the service package that implements its transparent tagging.
+type taggedResourceType struct {
+	servicePackage conns.ServicePackage
+	tags           *types.ServicePackageResourceTags // Nil if the resource type doesn't use transparent tagging.
+}
+
+var taggedResourceTypes sync.Map // Type name -> taggedResourceType.
+
+// ListTags returns the tags of a resource that uses transparent tagging, read using its service package's ListTags method.
+// Transparent tagging sets tags after the resource's Read, so they are not otherwise known when sweeping.
+// identifier returns the value of the named attribute of the resource.
+// Nil tags are returned if the resource type doesn't use transparent tagging.
+func ListTags(ctx context.Context, client *conns.AWSClient, typeName string, identifier func(string) string) (map[string]string, error) {
+	r := findTaggedResourceType(ctx, client, typeName)
+	if r.tags == nil || r.tags.IdentifierAttribute == "" {
+		return nil, nil
+	}
+
+	id := identifier(r.tags.IdentifierAttribute)
+	if id == "" {
+		return nil, nil
+	}
+
+	ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+	var err error
+	if v, ok := r.servicePackage.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		err = v.ListTags(ctx, client, id) // Sets tags in Context
+	} else if v, ok := r.servicePackage.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && r.tags.ResourceType != "" {
+		err = v.ListTags(ctx, client, id, r.tags.ResourceType) // Sets tags in Context
+	} else {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing tags for %s (%s): %w", typeName, id, err)
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		return inContext.TagsOut.UnwrapOrDefault().Map(), nil
+	}
+
+	return nil, nil
+}
+
+// findTaggedResourceType returns the specified resource type's transparent tagging configuration.
+func findTaggedResourceType(ctx context.Context, client *conns.AWSClient, typeName string) taggedResourceType {
+	if v, ok := taggedResourceTypes.Load(typeName); ok {
+		return v.(taggedResourceType)
+	}
+
+	var r taggedResourceType
+	for _, sp := range client.ServicePackages {
+		if v, ok := findTaggedResourceTypeInServicePackage(ctx, sp, typeName); ok {
+			r = v
+			break
+		}
+	}
+
+	taggedResourceTypes.Store(typeName, r)
+
+	return r
+}
+
+func findTaggedResourceTypeInServicePackage(ctx context.Context, sp conns.ServicePackage, typeName string) (taggedResourceType, bool) {
+	for _, v := range sp.SDKResources(ctx) {
+		if v.TypeName == typeName {
+			return taggedResourceType{servicePackage: sp, tags: v.Tags}, true
+		}
+	}
+
+	for _, v := range sp.FrameworkResources(ctx) {
+		// Framework resources declare their type name in their Metadata.
+		if v.Tags == nil {
+			continue
+		}
+
+		inner, err := v.Factory(ctx)
+		if err != nil {
+			continue
+		}
+
+		var response resource.MetadataResponse
+		inner.Metadata(ctx, resource.MetadataRequest{}, &response)
+
+		if response.TypeName == typeName {
+			return taggedResourceType{servicePackage: sp, tags: v.Tags}, true
+		}
+	}
+
+	return taggedResourceType{}, false
+}
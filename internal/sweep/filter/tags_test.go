@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testServicePackage struct {
+	resources []*types.ServicePackageSDKResource
+	tags      map[string]map[string]string // Identifier -> tags.
+}
+
+func (p *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return p.resources
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (p *testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	tags, ok := p.tags[identifier]
+	if !ok {
+		return errors.New("not found")
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, tags))
+	}
+
+	return nil
+}
+
+func TestListTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			"test": &testServicePackage{
+				resources: []*types.ServicePackageSDKResource{
+					{
+						TypeName: "aws_test_tagged_by_arn",
+						Tags:     &types.ServicePackageResourceTags{IdentifierAttribute: names.AttrARN},
+					},
+					{
+						TypeName: "aws_test_tagged_by_id",
+						Tags:     &types.ServicePackageResourceTags{IdentifierAttribute: names.AttrID},
+					},
+					{
+						TypeName: "aws_test_untagged",
+					},
+				},
+				tags: map[string]map[string]string{
+					"arn:aws:test:::r-1": {"keep": "true"},
+					"r-2":                {},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		typeName   string
+		attributes map[string]string
+		expected   map[string]string
+		wantErr    bool
+	}{
+		"tagged by ARN": {
+			typeName:   "aws_test_tagged_by_arn",
+			attributes: map[string]string{names.AttrARN: "arn:aws:test:::r-1"},
+			expected:   map[string]string{"keep": "true"},
+		},
+		"no tags": {
+			typeName:   "aws_test_tagged_by_id",
+			attributes: map[string]string{names.AttrID: "r-2"},
+			expected:   map[string]string{},
+		},
+		"identifier unknown": {
+			typeName: "aws_test_tagged_by_arn",
+		},
+		"list tags error": {
+			typeName:   "aws_test_tagged_by_id",
+			attributes: map[string]string{names.AttrID: "r-3"},
+			wantErr:    true,
+		},
+		"not transparently tagged": {
+			typeName:   "aws_test_untagged",
+			attributes: map[string]string{names.AttrID: "r-1"},
+		},
+		"unknown resource type": {
+			typeName:   "aws_test_unknown",
+			attributes: map[string]string{names.AttrID: "r-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ListTags(ctx, client, testCase.typeName, func(attr string) string {
+				return testCase.attributes[attr]
+			})
+
+			if gotErr := err != nil; gotErr != testCase.wantErr {
+				t.Fatalf("expected error %t, got %v", testCase.wantErr, err)
+			}
+			if (got == nil) != (testCase.expected == nil) || !maps.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceTypeFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if _, ok := ResourceTypeFromContext(ctx); ok {
+		t.Error("expected no resource type")
+	}
+
+	if got, ok := ResourceTypeFromContext(NewResourceTypeContext(ctx, "aws_sqs_queue")); !ok || got != "aws_sqs_queue" {
+		t.Errorf("expected aws_sqs_queue, got %q", got)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct{}
+
+func (testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+type testDescribableSweepable struct {
+	testSweepable
+	resource *filter.Resource
+}
+
+func (sr testDescribableSweepable) Describe(context.Context) (*filter.Resource, error) {
+	return sr.resource, nil
+}
+
+func TestKeepSweepable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := filter.Filter{NamePrefixes: []string{"tf-acc-test"}}
+
+	testCases := map[string]struct {
+		sweepable Sweepable
+		filter    filter.Filter
+		wantKeep  bool
+		wantErr   error
+	}{
+		"not describable no filter": {
+			sweepable: testSweepable{},
+		},
+		"not describable filter": {
+			sweepable: testSweepable{},
+			filter:    f,
+			wantKeep:  true,
+			wantErr:   errNotDescribable,
+		},
+		"describable kept": {
+			sweepable: testDescribableSweepable{resource: &filter.Resource{ID: "id", Name: "production"}},
+			filter:    f,
+			wantKeep:  true,
+		},
+		"describable swept": {
+			sweepable: testDescribableSweepable{resource: &filter.Resource{ID: "id", Name: "tf-acc-test-1"}},
+			filter:    f,
+		},
+		"describable gone": {
+			sweepable: testDescribableSweepable{},
+			filter:    f,
+			wantKeep:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, keep, err := keepSweepable(ctx, testCase.sweepable, testCase.filter)
+
+			if got, want := err, testCase.wantErr; !errors.Is(got, want) {
+				t.Errorf("expected error %v, got %v", want, got)
+			}
+			if got, want := keep, testCase.wantKeep; got != want {
+				t.Errorf("expected keep %t, got %t", want, got)
+			}
+		})
+	}
+}
+
+func TestAPICallGuard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orchestratorCtx := context.WithValue(ctx, orchestratorDeleteContextKey{}, true)
+	f := filter.Filter{NamePrefixes: []string{"tf-acc-test"}}
+
+	if guard := apiCallGuard(false, filter.Filter{}); guard != nil {
+		t.Error("expected no guard without dry-run mode or filter")
+	}
+
+	testCases := map[string]struct {
+		dryRun    bool
+		filter    filter.Filter
+		ctx       context.Context
+		operation string
+		wantErr   bool
+	}{
+		"dry-run read": {
+			dryRun:    true,
+			ctx:       ctx,
+			operation: "DescribeVpcs",
+		},
+		"dry-run delete": {
+			dryRun:    true,
+			ctx:       ctx,
+			operation: "DeleteVpc",
+			wantErr:   true,
+		},
+		"filter read": {
+			filter:    f,
+			ctx:       ctx,
+			operation: "ListTagsForResource",
+		},
+		"filter delete": {
+			filter:    f,
+			ctx:       ctx,
+			operation: "DeleteRouteTable",
+			wantErr:   true,
+		},
+		"filter modify": {
+			filter:    f,
+			ctx:       ctx,
+			operation: "RevokeSecurityGroupIngress",
+			wantErr:   true,
+		},
+		"filter orchestrator delete": {
+			filter:    f,
+			ctx:       orchestratorCtx,
+			operation: "DeleteVpc",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := apiCallGuard(testCase.dryRun, testCase.filter)(testCase.ctx, testCase.operation)
+
+			if got, want := errors.Is(err, errNotOrchestrated), testCase.wantErr; got != want {
+				t.Errorf("expected error %t, got %v", want, err)
+			}
+		})
+	}
+}
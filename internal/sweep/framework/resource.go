@@ -19,7 +19,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,11 +51,12 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+// newResource returns the configured resource and its state populated from the sweeper-specified attributes.
+func (sr *sweepResource) newResource(ctx context.Context) (context.Context, fwresource.ResourceWithConfigure, tfsdk.State, error) {
 	resource, err := sr.factory(ctx)
 
 	if err != nil {
-		return err
+		return ctx, nil, tfsdk.State{}, err
 	}
 
 	metadata := resourceMetadata(ctx, resource)
@@ -71,11 +75,86 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
+	return ctx, resource, state, nil
+}
+
+// Describe reads the resource and returns the information used to filter swept resources.
+// A nil description is returned if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (*filter.Resource, error) {
+	ctx, resource, state, err := sr.newResource(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := response.State.Raw.As(&attributes); err != nil {
+		return nil, err
+	}
+
+	r := &filter.Resource{
+		ID:   stringAttribute(attributes, names.AttrID),
+		Name: stringAttribute(attributes, names.AttrName),
+	}
+
+	// Tags set by the resource's Read take precedence. Tags of resources that use transparent tagging are listed.
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		r.Tags = inContext.TagsOut.UnwrapOrDefault().Map()
+	} else {
+		tags, err := filter.ListTags(ctx, sr.meta, resourceMetadata(ctx, resource).TypeName, func(attr string) string {
+			return stringAttribute(attributes, attr)
+		})
+		if err != nil {
+			tflog.Warn(ctx, "Tags unknown", map[string]any{
+				"error": err.Error(),
+			})
+		}
+		r.Tags = tags
+	}
+	if r.Tags == nil {
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			if v := mapAttribute(attributes, k); len(v) > 0 {
+				r.Tags = v
+				break
+			}
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes {
+		if v, ok := filter.ParseTime(stringAttribute(attributes, k)); ok {
+			r.CreatedAt = v
+			break
+		}
+	}
+
+	return r, nil
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	ctx, resource, state, err := sr.newResource(ctx)
+
+	if err != nil {
+		return err
+	}
+
 	tflog.Info(ctx, "Sweeping resource")
 
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
@@ -125,6 +204,39 @@ func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.
 	return fwdiag.DiagnosticsError(response.Diagnostics)
 }
 
+// stringAttribute returns the value of the specified string attribute, or an empty string if it is not set.
+func stringAttribute(attributes map[string]tftypes.Value, name string) string {
+	var s string
+
+	if v, ok := attributes[name]; ok && v.IsKnown() && !v.IsNull() {
+		if err := v.As(&s); err != nil {
+			return ""
+		}
+	}
+
+	return s
+}
+
+// mapAttribute returns the value of the specified map of strings attribute, or nil if it is not set.
+func mapAttribute(attributes map[string]tftypes.Value, name string) map[string]string {
+	var elements map[string]tftypes.Value
+
+	if v, ok := attributes[name]; !ok || !v.IsKnown() || v.IsNull() || v.As(&elements) != nil {
+		return nil
+	}
+
+	m := make(map[string]string, len(elements))
+	for k, v := range elements {
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil
+		}
+		m[k] = s
+	}
+
+	return m
+}
+
 func resourceMetadata(ctx context.Context, resource fwresource.Resource) fwresource.MetadataResponse {
 	var response fwresource.MetadataResponse
 	resource.Metadata(ctx, fwresource.MetadataRequest{}, &response)
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	flagSweepImport = flag.String("sweep-import", "", "Path of a Terraform configuration file of import blocks, written instead of sweeping. Only Sweepers added via Register are supported")
+)
+
+// listers is the registry of the listing functions of all Sweepers added via Register.
+var listers = struct {
+	lock  sync.Mutex
+	store map[string]SweeperFn
+}{
+	store: make(map[string]SweeperFn),
+}
+
+func addLister(name string, f SweeperFn) {
+	listers.lock.Lock()
+	defer listers.lock.Unlock()
+
+	listers.store[name] = f
+}
+
+// ImportBlock is a Terraform import block for an existing resource.
+type ImportBlock struct {
+	To       string // The resource address, for example aws_sqs_queue.example.
+	ID       string // The resource's import ID.
+	Provider string // The provider configuration address. Empty for the default provider configuration.
+}
+
+// ImportGenerator generates Terraform import blocks for the resources listed by Sweepers.
+// Resources are listed and read, never deleted.
+type ImportGenerator struct {
+	Regions []string // The Regions to scan. If more than one, blocks use a provider configuration aliased by Region.
+	Run     string   // Comma-separated list of case-insensitive substrings of Sweeper names, as for Runner. Dependencies are not included. Empty means all.
+
+	clients func(context.Context, string) (*conns.AWSClient, error) // Overrides SharedRegionalSweepClient. Used in testing.
+	listers map[string]SweeperFn                                    // Overrides the registry. Used in testing.
+}
+
+// Generate returns import blocks for all resources in all Regions, sorted by address.
+// Blocks are returned for the resources that could be listed even if an error occurs.
+func (g *ImportGenerator) Generate(ctx context.Context) ([]ImportBlock, error) {
+	selected, err := g.selectedListers()
+	if err != nil {
+		return nil, err
+	}
+
+	clients := g.clients
+	if clients == nil {
+		clients = SharedRegionalSweepClient
+	}
+
+	var blocks []ImportBlock
+	var errs []error
+
+	for _, region := range g.Regions {
+		ctx := tflog.SetField(ctx, loggingKeySweeperRegion, region)
+
+		client, err := clients(ctx, region)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("getting client (%s): %w", region, err))
+			continue
+		}
+
+		for _, name := range sortedKeys(selected) {
+			v, err := g.describe(logWithResourceType(ctx, name), client, name, selected[name])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listing %q (%s): %w", name, region, err))
+			}
+
+			for _, r := range v {
+				block := ImportBlock{
+					To: name + "." + r.label,
+					ID: r.id,
+				}
+				if len(g.Regions) > 1 {
+					block.To = name + "." + resourceLabel(region+"_"+r.label)
+					block.Provider = "aws." + resourceLabel(region)
+				}
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	blocks = uniqueAddresses(blocks)
+
+	return blocks, errors.Join(errs...)
+}
+
+type importableResource struct {
+	id, label string
+}
+
+// describe lists and reads the resources of a single Sweeper.
+// Resources that cannot be described or that no longer exist are skipped.
+func (g *ImportGenerator) describe(ctx context.Context, client *conns.AWSClient, name string, f SweeperFn) ([]importableResource, error) {
+	sweepables, err := f(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lock sync.Mutex
+	var resources []importableResource
+	var group multierror.Group
+
+	for _, sweepable := range sweepables {
+		sweepable := sweepable
+
+		group.Go(func() error {
+			v, ok := sweepable.(Describable)
+			if !ok {
+				tflog.Warn(ctx, "Skipping resource", map[string]any{
+					"reason":    "resource cannot be described",
+					"sweepable": fmt.Sprintf("%T", sweepable),
+				})
+				return nil
+			}
+
+			r, err := v.Describe(ctx)
+			if err != nil {
+				return fmt.Errorf("describing resource: %w", err)
+			}
+
+			if r == nil || r.ID == "" {
+				return nil
+			}
+
+			label := r.Name
+			if label == "" {
+				label = r.ID
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			resources = append(resources, importableResource{
+				id:    r.ID,
+				label: resourceLabel(label),
+			})
+
+			return nil
+		})
+	}
+
+	err = group.Wait().ErrorOrNil()
+
+	return resources, err
+}
+
+// selectedListers returns the listing functions of the Sweepers matching the Run filter.
+// It is an error if a Sweeper matching the filter was not added via Register.
+func (g *ImportGenerator) selectedListers() (map[string]SweeperFn, error) {
+	all := g.listers
+	if all == nil {
+		listers.lock.Lock()
+		all = make(map[string]SweeperFn, len(listers.store))
+		for k, v := range listers.store {
+			all[k] = v
+		}
+		listers.lock.Unlock()
+	}
+
+	if g.Run == "" {
+		return all, nil
+	}
+
+	matches := sweeperNameMatcher(g.Run)
+
+	var unsupported []string
+	if g.listers == nil {
+		sweepers.lock.Lock()
+		for name := range sweepers.store {
+			if _, ok := all[name]; !ok && matches(name) {
+				unsupported = append(unsupported, name)
+			}
+		}
+		sweepers.lock.Unlock()
+	}
+
+	if len(unsupported) > 0 {
+		slices.Sort(unsupported)
+		return nil, fmt.Errorf("sweepers not added via Register do not support import generation: %s", strings.Join(unsupported, ", "))
+	}
+
+	selected := make(map[string]SweeperFn)
+	for name, f := range all {
+		if matches(name) {
+			selected[name] = f
+		}
+	}
+
+	return selected, nil
+}
+
+// WriteImportBlocks writes the specified import blocks as Terraform configuration.
+func WriteImportBlocks(w io.Writer, blocks []ImportBlock) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, block := range blocks {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		to, err := traversal(block.To)
+		if err != nil {
+			return fmt.Errorf("resource address: %w", err)
+		}
+
+		b := body.AppendNewBlock("import", nil).Body()
+		b.SetAttributeTraversal("to", to)
+		b.SetAttributeValue(names.AttrID, cty.StringVal(block.ID))
+
+		if block.Provider != "" {
+			provider, err := traversal(block.Provider)
+			if err != nil {
+				return fmt.Errorf("provider address: %w", err)
+			}
+			b.SetAttributeTraversal("provider", provider)
+		}
+	}
+
+	_, err := f.WriteTo(w)
+
+	return err
+}
+
+// traversal returns the traversal of an address of the form type.name.
+func traversal(address string) (hcl.Traversal, error) {
+	root, name, ok := strings.Cut(address, ".")
+	if !ok || root == "" || name == "" || strings.Contains(name, ".") {
+		return nil, fmt.Errorf("invalid address (%s)", address)
+	}
+
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: root},
+		hcl.TraverseAttr{Name: name},
+	}, nil
+}
+
+// runImportGenerator generates import blocks for the specified Regions and writes them to the specified path.
+func runImportGenerator(ctx context.Context, path string, regions []string, run string) error {
+	generator := &ImportGenerator{
+		Regions: regions,
+		Run:     run,
+	}
+
+	blocks, err := generator.Generate(ctx)
+
+	// Write the blocks that were generated even if listing some resources failed.
+	if err != nil && len(blocks) == 0 {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := WriteImportBlocks(&buf, blocks); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil { //nolint:mnd // Configuration is not sensitive.
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "wrote %d import blocks to %s\n", len(blocks), path)
+
+	return err
+}
+
+// resourceLabel returns a valid Terraform resource name derived from the specified string.
+func resourceLabel(s string) string {
+	var sb strings.Builder
+
+	underscore := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			sb.WriteRune(r)
+			underscore = false
+		} else if !underscore {
+			sb.WriteRune('_')
+			underscore = true
+		}
+	}
+
+	label := strings.Trim(sb.String(), "_")
+
+	if label == "" {
+		return "resource"
+	}
+	if !(label[0] >= 'a' && label[0] <= 'z') {
+		label = "r_" + label
+	}
+
+	return label
+}
+
+// uniqueAddresses sorts the specified blocks by address, suffixing duplicate addresses with a counter.
+// Blocks with duplicate addresses are ordered by ID so that suffixes are stable.
+func uniqueAddresses(blocks []ImportBlock) []ImportBlock {
+	slices.SortFunc(blocks, func(a, b ImportBlock) int {
+		if v := strings.Compare(a.To, b.To); v != 0 {
+			return v
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	used := make(map[string]bool, len(blocks))
+	for i, block := range blocks {
+		to := block.To
+		for n := 2; used[to]; n++ {
+			to = block.To + "_" + strconv.Itoa(n)
+		}
+		used[to] = true
+		blocks[i].To = to
+	}
+
+	slices.SortFunc(blocks, func(a, b ImportBlock) int {
+		return strings.Compare(a.To, b.To)
+	})
+
+	return blocks
+}
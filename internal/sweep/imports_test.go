@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testImportSweepable struct {
+	resource *filter.Resource
+	err      error
+}
+
+func (s testImportSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return errors.New("unexpected delete")
+}
+
+func (s testImportSweepable) Describe(context.Context) (*filter.Resource, error) {
+	return s.resource, s.err
+}
+
+type testUndescribableSweepable struct{}
+
+func (testUndescribableSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return errors.New("unexpected delete")
+}
+
+func testImportLister(sweepables ...Sweepable) SweeperFn {
+	return func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+		return sweepables, nil
+	}
+}
+
+func testImportClients(context.Context, string) (*conns.AWSClient, error) {
+	return new(conns.AWSClient), nil
+}
+
+func TestImportGeneratorGenerate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testListers := map[string]SweeperFn{
+		"aws_example_thing": testImportLister(
+			testImportSweepable{resource: &filter.Resource{ID: "thing-2", Name: "Production Thing"}},
+			testImportSweepable{resource: &filter.Resource{ID: "thing-1", Name: "production-thing"}},
+			testImportSweepable{resource: &filter.Resource{ID: "arn:aws:example:us-west-2:123456789012:thing/3"}},
+			testImportSweepable{}, // No longer exists.
+			testUndescribableSweepable{},
+		),
+		"aws_example_widget": testImportLister(
+			testImportSweepable{resource: &filter.Resource{ID: "w-1", Name: "widget"}},
+		),
+	}
+
+	testCases := map[string]struct {
+		regions  []string
+		run      string
+		expected []ImportBlock
+	}{
+		"single region": {
+			regions: []string{"us-west-2"},
+			expected: []ImportBlock{
+				{To: "aws_example_thing.arn_aws_example_us-west-2_123456789012_thing_3", ID: "arn:aws:example:us-west-2:123456789012:thing/3"},
+				{To: "aws_example_thing.production-thing", ID: "thing-1"},
+				{To: "aws_example_thing.production_thing", ID: "thing-2"},
+				{To: "aws_example_widget.widget", ID: "w-1"},
+			},
+		},
+		"run": {
+			regions: []string{"us-west-2"},
+			run:     "aws_example_w",
+			expected: []ImportBlock{
+				{To: "aws_example_widget.widget", ID: "w-1"},
+			},
+		},
+		"run substring": {
+			regions: []string{"us-west-2"},
+			run:     "WIDGET",
+			expected: []ImportBlock{
+				{To: "aws_example_widget.widget", ID: "w-1"},
+			},
+		},
+		"multiple regions": {
+			regions: []string{"us-west-2", "us-east-1"},
+			run:     "aws_example_widget",
+			expected: []ImportBlock{
+				{To: "aws_example_widget.us-east-1_widget", ID: "w-1", Provider: "aws.us-east-1"},
+				{To: "aws_example_widget.us-west-2_widget", ID: "w-1", Provider: "aws.us-west-2"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := &ImportGenerator{
+				Regions: testCase.regions,
+				Run:     testCase.run,
+				clients: testImportClients,
+				listers: testListers,
+			}
+
+			got, err := generator.Generate(ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestImportGeneratorGenerateError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generator := &ImportGenerator{
+		Regions: []string{"us-west-2"},
+		clients: testImportClients,
+		listers: map[string]SweeperFn{
+			"aws_example_thing": testImportLister(
+				testImportSweepable{err: errors.New("read failed")},
+			),
+			"aws_example_widget": testImportLister(
+				testImportSweepable{resource: &filter.Resource{ID: "w-1", Name: "widget"}},
+			),
+		},
+	}
+
+	got, err := generator.Generate(ctx)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	expected := []ImportBlock{
+		{To: "aws_example_widget.widget", ID: "w-1"},
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWriteImportBlocks(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+	err := WriteImportBlocks(&sb, []ImportBlock{
+		{To: "aws_example_thing.example", ID: "thing-1"},
+		{To: "aws_example_thing.us-east-1_example", ID: "thing-2", Provider: "aws.us-east-1"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `import {
+  to = aws_example_thing.example
+  id = "thing-1"
+}
+
+import {
+  to       = aws_example_thing.us-east-1_example
+  id       = "thing-2"
+  provider = aws.us-east-1
+}
+`
+	if got := sb.String(); got != expected {
+		t.Errorf("got\n%s\nwant\n%s", got, expected)
+	}
+}
+
+func TestResourceLabel(t *testing.T) {
+	t.Parallel()
+
+	for s, expected := range map[string]string{
+		"example":              "example",
+		"Example Thing":        "example_thing",
+		"tf-acc-test-123":      "tf-acc-test-123",
+		"/aws/lambda/function": "aws_lambda_function",
+		"123abc":               "r_123abc",
+		"":                     "resource",
+	} {
+		if got := resourceLabel(s); got != expected {
+			t.Errorf("resourceLabel(%q) = %q, want %q", s, got, expected)
+		}
+	}
+}
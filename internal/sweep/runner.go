@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+var (
+	flagSweepParallelism = flag.Int("sweep-parallelism", 0, "Enable to run independent Sweepers concurrently, with at most this many Sweepers running per Region")
+	flagSweepReport      = flag.String("sweep-report", "", "Path of a JSON report of Sweeper results, written when -sweep-parallelism is set")
+)
+
+// sweepers is the registry of all Sweepers added via AddTestSweepers.
+var sweepers = struct {
+	lock  sync.Mutex
+	store map[string]*resource.Sweeper
+}{
+	store: make(map[string]*resource.Sweeper),
+}
+
+// sweptCounts records the number of resources deleted by each Sweeper in each Region, where known.
+var sweptCounts sync.Map // sweptCountKey -> int.
+
+type sweptCountKey struct {
+	name, region string
+}
+
+func recordSweptCount(name, region string, n int) {
+	sweptCounts.Store(sweptCountKey{name: name, region: region}, n)
+}
+
+// AddTestSweepers adds a Sweeper to both the Terraform Plugin Testing sweeper framework and
+// this package's registry, from which Sweepers can be run concurrently.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers.lock.Lock()
+	sweepers.store[name] = s
+	sweepers.lock.Unlock()
+
+	resource.AddTestSweepers(name, s)
+}
+
+// TestMain generates import blocks or runs registered Sweepers concurrently if requested, otherwise delegating to the Terraform Plugin Testing TestMain.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions != "" && *flagSweepImport != "" {
+		if err := runImportGenerator(context.Background(), *flagSweepImport, strings.Split(regions, ","), flagValue("sweep-run")); err != nil {
+			fmt.Fprintf(os.Stderr, "import generation failed: %s\n", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	if regions == "" || *flagSweepParallelism < 1 {
+		resource.TestMain(m)
+		return
+	}
+
+	runner := &Runner{
+		AllowFailures: flagValue("sweep-allow-failures") == "true",
+		Parallelism:   *flagSweepParallelism,
+		Regions:       strings.Split(regions, ","),
+		Run:           flagValue("sweep-run"),
+	}
+
+	report, err := runner.RunSweepers(context.Background())
+
+	if *flagSweepReport != "" && report != nil {
+		if err := report.WriteFile(*flagSweepReport); err != nil {
+			fmt.Fprintf(os.Stderr, "writing sweeper report: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sweeper run failed: %s\n", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// flagValue returns the value of a flag defined by the Terraform Plugin Testing sweeper framework.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+	return ""
+}
+
+// Runner runs registered Sweepers in dependency order.
+// Sweepers whose dependencies have completed run concurrently, and all Regions are swept concurrently.
+type Runner struct {
+	AllowFailures bool     // Whether to continue after a Sweeper fails.
+	Parallelism   int      // The maximum number of concurrently running Sweepers per Region.
+	Regions       []string // The Regions to sweep.
+	Run           string   // Comma-separated list of case-insensitive substrings of Sweeper names to run, with their dependencies. Empty means all.
+
+	sweepers map[string]*resource.Sweeper // Overrides the registry. Used in testing.
+}
+
+const (
+	SweeperStatusFailed  = "failed"
+	SweeperStatusRefused = "refused" // The Sweeper modified resources without using the sweep orchestrator in dry-run mode or with filters, or returned resources that can't be filtered.
+	SweeperStatusSkipped = "skipped"
+	SweeperStatusSwept   = "swept"
+)
+
+// Report is a machine-readable summary of a sweeper run.
+type Report struct {
+	StartTime time.Time       `json:"start_time"`
+	EndTime   time.Time       `json:"end_time"`
+	DryRun    bool            `json:"dry_run"`
+	Results   []SweeperResult `json:"results"`
+
+	lock sync.Mutex
+}
+
+// SweeperResult is the result of running a single Sweeper in a single Region.
+type SweeperResult struct {
+	Name     string        `json:"name"`
+	Region   string        `json:"region"`
+	Status   string        `json:"status"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration_ns"`
+	Swept    *int          `json:"resources_swept,omitempty"` // In dry-run mode, the number that would be swept. Only known for Sweepers added via Register.
+}
+
+func (r *Report) add(result SweeperResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Results = append(r.Results, result)
+}
+
+// WriteFile writes the report as JSON to the specified path.
+func (r *Report) WriteFile(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	slices.SortFunc(r.Results, func(a, b SweeperResult) int {
+		if v := strings.Compare(a.Region, b.Region); v != 0 {
+			return v
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0644) //nolint:mnd // Report is not sensitive.
+}
+
+// RunSweepers runs the Sweepers in all Regions and returns a report of the results.
+func (r *Runner) RunSweepers(ctx context.Context) (*Report, error) {
+	dryRun, _, err := sweepFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		StartTime: time.Now(),
+		DryRun:    dryRun,
+	}
+
+	selected := r.selectedSweepers()
+
+	graph, err := sweeperGraph(selected)
+	if err != nil {
+		return report, err
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(r.Regions))
+
+	for i, region := range r.Regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			errs[i] = r.runRegion(ctx, region, graph, selected, report)
+		}(i, region)
+	}
+
+	wg.Wait()
+	report.EndTime = time.Now()
+
+	return report, errors.Join(errs...)
+}
+
+// runRegion runs the Sweepers in a single Region.
+// A Sweeper runs once all of its dependencies have completed. If a dependency fails, dependent Sweepers are skipped.
+func (r *Runner) runRegion(ctx context.Context, region string, graph *depgraph.Graph, selected map[string]*resource.Sweeper, report *Report) error {
+	type completion struct {
+		name string
+		err  error
+	}
+
+	parallelism := max(r.Parallelism, 1)
+	pending := make(map[string]struct{}, len(selected))
+	for name := range selected {
+		pending[name] = struct{}{}
+	}
+	done := make(map[string]bool) // Sweeper name -> succeeded.
+	completions := make(chan completion)
+	running := 0
+	var errs []error
+	halted := false
+
+	for len(pending) > 0 || running > 0 {
+		if !halted {
+			for _, name := range sortedKeys(pending) {
+				if running >= parallelism {
+					break
+				}
+
+				dependencies, _ := graph.DirectDependenciesOf(name)
+				ready, skip := true, false
+				for _, dependency := range dependencies {
+					succeeded, ok := done[dependency]
+					if !ok {
+						ready = false
+						break
+					}
+					if !succeeded {
+						skip = true
+					}
+				}
+				if !ready {
+					continue
+				}
+
+				delete(pending, name)
+
+				if skip {
+					done[name] = false
+					report.add(SweeperResult{
+						Name:   name,
+						Region: region,
+						Status: SweeperStatusSkipped,
+						Error:  "dependency failed or was skipped",
+					})
+					continue
+				}
+
+				running++
+				go func(name string, s *resource.Sweeper) {
+					completions <- completion{name: name, err: r.runSweeper(ctx, region, name, s, report)}
+				}(name, selected[name])
+			}
+		}
+
+		if running == 0 {
+			if len(pending) > 0 && !halted {
+				// Skipped Sweepers may have made further Sweepers ready.
+				continue
+			}
+			break
+		}
+
+		c := <-completions
+		running--
+		done[c.name] = c.err == nil
+		if c.err != nil {
+			errs = append(errs, c.err)
+			if !r.AllowFailures {
+				halted = true
+			}
+		}
+	}
+
+	if halted {
+		for _, name := range sortedKeys(pending) {
+			report.add(SweeperResult{
+				Name:   name,
+				Region: region,
+				Status: SweeperStatusSkipped,
+				Error:  "sweeper run halted after failure",
+			})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Runner) runSweeper(ctx context.Context, region, name string, s *resource.Sweeper, report *Report) error {
+	ctx = tflog.SetField(ctx, loggingKeySweeperRegion, region)
+	ctx = logWithResourceType(ctx, name)
+
+	tflog.Info(ctx, "Running sweeper")
+
+	key := sweptCountKey{name: name, region: region}
+	sweptCounts.Delete(key)
+
+	start := time.Now()
+	err := s.F(region)
+	result := SweeperResult{
+		Name:     name,
+		Region:   region,
+		Status:   SweeperStatusSwept,
+		Duration: time.Since(start),
+	}
+
+	if v, ok := sweptCounts.Load(key); ok {
+		n := v.(int)
+		result.Swept = &n
+	}
+
+	if err != nil {
+		result.Status = SweeperStatusFailed
+		if errors.Is(err, errNotOrchestrated) || errors.Is(err, errNotDescribable) {
+			result.Status = SweeperStatusRefused
+		}
+		result.Error = err.Error()
+		err = fmt.Errorf("sweeping %q (%s): %w", name, region, err)
+	}
+
+	report.add(result)
+
+	return err
+}
+
+// selectedSweepers returns the Sweepers matching the Run filter, along with their transitive dependencies.
+func (r *Runner) selectedSweepers() map[string]*resource.Sweeper {
+	all := r.sweepers
+	if all == nil {
+		sweepers.lock.Lock()
+		all = make(map[string]*resource.Sweeper, len(sweepers.store))
+		for k, v := range sweepers.store {
+			all[k] = v
+		}
+		sweepers.lock.Unlock()
+	}
+
+	if r.Run == "" {
+		return all
+	}
+
+	selected := make(map[string]*resource.Sweeper)
+	var add func(string)
+	add = func(name string) {
+		s, ok := all[name]
+		if !ok {
+			return
+		}
+		if _, ok := selected[name]; ok {
+			return
+		}
+		selected[name] = s
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	matches := sweeperNameMatcher(r.Run)
+	for name := range all {
+		if matches(name) {
+			add(name)
+		}
+	}
+
+	return selected
+}
+
+// sweeperNameMatcher returns a function that reports whether a Sweeper name matches the specified filter.
+// As with terraform-plugin-testing's -sweep-run flag, the filter is a comma-separated list of case-insensitive substrings.
+func sweeperNameMatcher(filter string) func(string) bool {
+	substrs := strings.Split(strings.ToLower(filter), ",")
+
+	return func(name string) bool {
+		name = strings.ToLower(name)
+		return slices.ContainsFunc(substrs, func(substr string) bool {
+			return strings.Contains(name, substr)
+		})
+	}
+}
+
+// sweeperGraph returns the dependency graph of the specified Sweepers.
+// A Sweeper's dependencies are Sweepers that must run before it.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	graph := depgraph.New()
+
+	for _, name := range sortedKeys(sweepers) {
+		graph.AddNode(name)
+	}
+
+	for _, name := range sortedKeys(sweepers) {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !graph.HasNode(dependency) {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+			if err := graph.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Detect any dependency cycle.
+	if _, err := graph.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type testSweeperLog struct {
+	lock  sync.Mutex
+	order map[string][]string // Region -> Sweeper names in order of completion.
+}
+
+func (l *testSweeperLog) sweeper(name string, err error, dependencies ...string) *resource.Sweeper {
+	return &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			time.Sleep(10 * time.Millisecond)
+
+			l.lock.Lock()
+			defer l.lock.Unlock()
+
+			if l.order == nil {
+				l.order = make(map[string][]string)
+			}
+			l.order[region] = append(l.order[region], name)
+
+			return err
+		},
+	}
+}
+
+func resultStatuses(report *Report, region string) map[string]string {
+	statuses := make(map[string]string)
+	for _, result := range report.Results {
+		if result.Region == region {
+			statuses[result.Name] = result.Status
+		}
+	}
+	return statuses
+}
+
+func TestRunnerRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	log := &testSweeperLog{}
+	runner := &Runner{
+		Parallelism: 4,
+		Regions:     []string{"us-west-2", "us-east-1"},
+		sweepers: map[string]*resource.Sweeper{
+			"aws_a": log.sweeper("aws_a", nil, "aws_b", "aws_c"),
+			"aws_b": log.sweeper("aws_b", nil, "aws_d"),
+			"aws_c": log.sweeper("aws_c", nil),
+			"aws_d": log.sweeper("aws_d", nil),
+		},
+	}
+
+	report, err := runner.RunSweepers(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, region := range runner.Regions {
+		order := log.order[region]
+		if got, want := len(order), 4; got != want {
+			t.Fatalf("%s: ran %d sweepers, want %d", region, got, want)
+		}
+		if i, j := slices.Index(order, "aws_d"), slices.Index(order, "aws_b"); i > j {
+			t.Errorf("%s: aws_b ran before its dependency aws_d: %v", region, order)
+		}
+		if got, want := order[3], "aws_a"; got != want {
+			t.Errorf("%s: last sweeper was %s, want %s: %v", region, got, want, order)
+		}
+		for name, status := range resultStatuses(report, region) {
+			if status != SweeperStatusSwept {
+				t.Errorf("%s: %s status was %s, want %s", region, name, status, SweeperStatusSwept)
+			}
+		}
+	}
+}
+
+func TestRunnerRunSweepers_failure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	log := &testSweeperLog{}
+	region := "us-west-2"
+	runner := &Runner{
+		AllowFailures: true,
+		Parallelism:   2,
+		Regions:       []string{region},
+		sweepers: map[string]*resource.Sweeper{
+			"aws_a": log.sweeper("aws_a", nil, "aws_b"),
+			"aws_b": log.sweeper("aws_b", nil, "aws_c"),
+			"aws_c": log.sweeper("aws_c", errors.New("failed")),
+			"aws_d": log.sweeper("aws_d", nil),
+			"aws_e": log.sweeper("aws_e", fmt.Errorf("deleting: %w", errNotOrchestrated)),
+			"aws_f": log.sweeper("aws_f", fmt.Errorf("filtering: %w", errNotDescribable)),
+		},
+	}
+
+	report, err := runner.RunSweepers(ctx)
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	want := map[string]string{
+		"aws_a": SweeperStatusSkipped,
+		"aws_b": SweeperStatusSkipped,
+		"aws_c": SweeperStatusFailed,
+		"aws_d": SweeperStatusSwept,
+		"aws_e": SweeperStatusRefused,
+		"aws_f": SweeperStatusRefused,
+	}
+	if got := resultStatuses(report, region); !maps.Equal(got, want) {
+		t.Errorf("statuses were %v, want %v", got, want)
+	}
+}
+
+func TestRunnerRunSweepers_parallelism(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var running, maxRunning atomic.Int32
+	sweeper := func(name string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name: name,
+			F: func(region string) error {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					m := maxRunning.Load()
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			},
+		}
+	}
+
+	runner := &Runner{
+		Parallelism: 2,
+		Regions:     []string{"us-west-2"},
+		sweepers: map[string]*resource.Sweeper{
+			"aws_a": sweeper("aws_a"),
+			"aws_b": sweeper("aws_b"),
+			"aws_c": sweeper("aws_c"),
+			"aws_d": sweeper("aws_d"),
+			"aws_e": sweeper("aws_e"),
+		},
+	}
+
+	if _, err := runner.RunSweepers(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := maxRunning.Load(), int32(2); got != want {
+		t.Errorf("maximum concurrent sweepers was %d, want %d", got, want)
+	}
+}
+
+func TestRunnerSelectedSweepers(t *testing.T) {
+	t.Parallel()
+
+	log := &testSweeperLog{}
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a":           log.sweeper("aws_a", nil),
+		"aws_b":           log.sweeper("aws_b", nil, "aws_c"),
+		"aws_b_v2":        log.sweeper("aws_b_v2", nil),
+		"aws_c":           log.sweeper("aws_c", nil, "aws_d"),
+		"aws_d":           log.sweeper("aws_d", nil),
+		"aws_default_vpc": log.sweeper("aws_default_vpc", nil),
+		"aws_vpc":         log.sweeper("aws_vpc", nil),
+	}
+
+	testCases := map[string]struct {
+		run  string
+		want []string
+	}{
+		"all": {
+			want: sortedKeys(sweepers),
+		},
+		"prefix": {
+			run:  "aws_b,aws_x",
+			want: []string{"aws_b", "aws_b_v2", "aws_c", "aws_d"},
+		},
+		"substring": {
+			run:  "vpc",
+			want: []string{"aws_default_vpc", "aws_vpc"},
+		},
+		"case insensitive": {
+			run:  "AWS_C",
+			want: []string{"aws_c", "aws_d"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			runner := &Runner{
+				Run:      testCase.run,
+				sweepers: sweepers,
+			}
+
+			got := sortedKeys(runner.selectedSweepers())
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("selected %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	log := &testSweeperLog{}
+	testCases := map[string]struct {
+		sweepers    map[string]*resource.Sweeper
+		expectError bool
+	}{
+		"valid": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": log.sweeper("aws_a", nil, "aws_b"),
+				"aws_b": log.sweeper("aws_b", nil),
+			},
+		},
+		"missing dependency": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": log.sweeper("aws_a", nil, "aws_b"),
+			},
+			expectError: true,
+		},
+		"cycle": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": log.sweeper("aws_a", nil, "aws_b"),
+				"aws_b": log.sweeper("aws_b", nil, "aws_a"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sweeperGraph(testCase.sweepers)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
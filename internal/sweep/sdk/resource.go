@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -16,8 +17,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +94,78 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe reads the resource and returns the information used to filter swept resources.
+// A nil description is returned if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (*filter.Resource, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+	ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, nil
+	}
+
+	r := &filter.Resource{
+		ID: sr.d.Id(),
+	}
+
+	schema := sr.resource.SchemaMap()
+
+	if _, ok := schema[names.AttrName]; ok {
+		if v, ok := sr.d.Get(names.AttrName).(string); ok {
+			r.Name = v
+		}
+	}
+
+	// Tags set by the resource's Read take precedence. Tags of resources that use transparent tagging are listed
+	// if the resource type is known.
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		r.Tags = inContext.TagsOut.UnwrapOrDefault().Map()
+	} else if typeName, ok := filter.ResourceTypeFromContext(ctx); ok {
+		tags, err := filter.ListTags(ctx, sr.meta, typeName, func(attr string) string {
+			if attr == names.AttrID {
+				return sr.d.Id()
+			}
+			// The sweeper may sweep resources of a type other than the one it is named for.
+			if _, ok := schema[attr]; !ok {
+				return ""
+			}
+			v, _ := sr.d.Get(attr).(string)
+			return v
+		})
+		if err != nil {
+			tflog.Warn(ctx, "Tags unknown", map[string]any{
+				"error": err.Error(),
+			})
+		}
+		r.Tags = tags
+	}
+	if r.Tags == nil {
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			if _, ok := schema[k]; ok {
+				if v, ok := sr.d.Get(k).(map[string]any); ok && len(v) > 0 {
+					r.Tags = flex.ExpandStringValueMap(v)
+					break
+				}
+			}
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes {
+		if _, ok := schema[k]; ok {
+			if v, ok := filter.ParseTime(fmt.Sprint(sr.d.Get(k))); ok {
+				r.CreatedAt = v
+				break
+			}
+		}
+	}
+
+	return r, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,10 +36,17 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+// Sweepers for different Regions may run concurrently, so access is guarded by sweeperClientsLock.
+var (
+	sweeperClients     map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsLock sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -61,7 +71,13 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 	meta.ServicePackages = servicePackageMap
 
+	dryRun, f, err := sweepFilter()
+	if err != nil {
+		return nil, err
+	}
+
 	conf := &conns.Config{
+		APICallGuard:     apiCallGuard(dryRun, f),
 		MaxRetries:       5,
 		Region:           region,
 		SuppressDebugLog: true,
@@ -104,22 +120,69 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+// Resources kept by the configured filter are not deleted, and in dry-run mode no resources are deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	_, err := sweepOrchestrator(ctx, sweepables, optFns...)
+
+	return err
+}
+
+// sweepOrchestrator deletes the specified resources concurrently, returning the number of resources deleted
+// or, in dry-run mode, the number that would have been deleted.
+func sweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) (int, error) {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	dryRun, f, err := sweepFilter()
+	if err != nil {
+		return 0, err
+	}
+
 	var g multierror.Group
+	var swept atomic.Int64
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			ctx := ctx
+
+			if dryRun || !f.IsEmpty() {
+				var keep bool
+				var err error
+
+				ctx, keep, err = keepSweepable(ctx, sweepable, f)
+				if err != nil {
+					return err
+				}
+				if keep {
+					return nil
+				}
+			}
+
+			if dryRun {
+				tflog.Info(ctx, "Would sweep resource (dry run)", map[string]any{
+					"sweepable": fmt.Sprintf("%T", sweepable),
+				})
+				swept.Add(1)
+				return nil
+			}
+
+			ctx = context.WithValue(ctx, orchestratorDeleteContextKey{}, true)
+			if err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...); err != nil {
+				return err
+			}
+			swept.Add(1)
+
+			return nil
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err = g.Wait().ErrorOrNil()
+
+	return int(swept.Load()), err
 }
 
 // Deprecated: Use awsv1.SkipSweepError
@@ -136,11 +199,15 @@ func PartitionDNSSuffix(region string) string {
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
 func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+	addLister(name, f)
+
+	AddTestSweepers(name, &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
+			ctx = filter.NewResourceTypeContext(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
 			if err != nil {
@@ -158,7 +225,8 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 				return fmt.Errorf("listing %q (%s): %w", name, region, err)
 			}
 
-			err = SweepOrchestrator(ctx, sweepResources)
+			n, err := sweepOrchestrator(ctx, sweepResources)
+			recordSweptCount(name, region, n)
 			if err != nil {
 				return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
 			}
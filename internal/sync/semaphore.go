@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+)
+
+// Semaphore can be used to limit concurrent executions.
+// This can be used to work with resources with low quotas.
+type Semaphore struct {
+	tokens chan struct{}
+}
+
+// NewSemaphore returns a semaphore that allows at most limit concurrent holders.
+// A limit less than 1 means that the number of concurrent holders is unlimited.
+func NewSemaphore(limit int) *Semaphore {
+	s := &Semaphore{}
+	if limit > 0 {
+		s.tokens = make(chan struct{}, limit)
+	}
+	return s
+}
+
+// Limit returns the maximum number of concurrent holders, 0 meaning unlimited.
+func (s *Semaphore) Limit() int {
+	return cap(s.tokens)
+}
+
+// Acquire waits until the semaphore is acquired or the context is done.
+// Caller is responsible for calling Release if, and only if, no error is returned.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	if s.tokens == nil {
+		return nil
+	}
+
+	select {
+	case s.tokens <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TryAcquire acquires the semaphore without waiting, reporting whether it succeeded.
+func (s *Semaphore) TryAcquire() bool {
+	if s.tokens == nil {
+		return true
+	}
+
+	select {
+	case s.tokens <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release releases the semaphore.
+func (s *Semaphore) Release() {
+	if s.tokens == nil {
+		return
+	}
+
+	select {
+	case <-s.tokens:
+	default:
+		panic("release of unacquired semaphore")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSemaphore(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphore(2)
+
+	if got, want := s.Limit(), 2; got != want {
+		t.Fatalf("Limit() = %d, want %d", got, want)
+	}
+
+	ctx := context.Background()
+	if err := s.Acquire(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !s.TryAcquire() {
+		t.Fatal("TryAcquire failed below limit. This shouldn't happen.")
+	}
+	if s.TryAcquire() {
+		t.Fatal("TryAcquire succeeded at limit. This shouldn't happen.")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	if err := s.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	s.Release()
+
+	if !s.TryAcquire() {
+		t.Fatal("TryAcquire failed after Release. This shouldn't happen.")
+	}
+}
+
+func TestSemaphoreUnlimited(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphore(0)
+
+	if got, want := s.Limit(), 0; got != want {
+		t.Fatalf("Limit() = %d, want %d", got, want)
+	}
+
+	for i := 0; i < 100; i++ {
+		if !s.TryAcquire() {
+			t.Fatal("TryAcquire failed on unlimited semaphore. This shouldn't happen.")
+		}
+	}
+
+	s.Release()
+}
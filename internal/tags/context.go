@@ -13,6 +13,7 @@ import (
 type InContext struct {
 	DefaultConfig *DefaultConfig
 	IgnoreConfig  *IgnoreConfig
+	PolicyConfig  *PolicyConfig
 	// TagsIn holds tags specified in configuration. Typically this field includes any default tags and excludes system tags.
 	TagsIn option.Option[KeyValueTags]
 	// TagsOut holds tags returned from AWS, including any ignored or system tags.
@@ -20,10 +21,11 @@ type InContext struct {
 }
 
 // NewContext returns a Context enhanced with tagging information.
-func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) context.Context {
+func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, policyConfig *PolicyConfig) context.Context {
 	v := InContext{
 		DefaultConfig: defaultConfig,
 		IgnoreConfig:  ignoreConfig,
+		PolicyConfig:  policyConfig,
 		TagsIn:        option.None[KeyValueTags](),
 		TagsOut:       option.None[KeyValueTags](),
 	}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// PolicyResourceInterceptor returns an interceptor that validates a resource's configured tags, merged with any provider configured default_tags,
+// against any provider configured tag policy when planning.
+// servicePackageName is the name of the resource's service package, used to ignore system tags.
+func PolicyResourceInterceptor(servicePackageName string) interceptors.ResourceInterceptor {
+	return interceptors.ResourceInterceptor{
+		When: interceptors.Before,
+		Why:  interceptors.ModifyPlan,
+		Interceptor: func(ctx context.Context, request interceptors.ResourceRequest) (context.Context, error) {
+			inContext, ok := FromContext(ctx)
+			if !ok || inContext.PolicyConfig == nil {
+				return ctx, nil
+			}
+
+			m, known := request.Data.ConfigMap(ctx, names.AttrTags)
+			if !known {
+				// The set of tag keys is not yet known.
+				return ctx, nil
+			}
+
+			configTags := make(KeyValueTags, len(m))
+			for k, v := range m {
+				configTags[k] = &TagData{Value: v}
+			}
+
+			return ctx, inContext.PolicyConfig.ValidateResource(request.TypeName, servicePackageName, inContext.DefaultConfig, configTags)
+		},
+	}
+}
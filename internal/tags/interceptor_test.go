@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockResourceData struct {
+	tags  map[string]*string
+	known bool
+}
+
+func (d mockResourceData) ID(context.Context) string {
+	return ""
+}
+
+func (d mockResourceData) ChangedAttributes(context.Context) []string {
+	return nil
+}
+
+func (d mockResourceData) ConfigMap(_ context.Context, name string) (map[string]*string, bool) {
+	if name != names.AttrTags {
+		return nil, false
+	}
+
+	return d.tags, d.known
+}
+
+func TestPolicyResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &PolicyConfig{
+		RequiredKeys:  []string{"Environment", "Owner"},
+		AllowedValues: map[string]*regexp.Regexp{"Environment": regexp.MustCompile(`^(dev|prod)$`)},
+		KeyCase:       KeyCasePascal,
+	}
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{"Owner": "platform"}),
+	}
+
+	testCases := map[string]struct {
+		policy        *PolicyConfig
+		data          mockResourceData
+		expectedError string
+	}{
+		"valid": {
+			policy: policy,
+			data:   mockResourceData{tags: map[string]*string{"Environment": aws.String("dev")}, known: true},
+		},
+		"missing key": {
+			policy:        policy,
+			data:          mockResourceData{tags: map[string]*string{}, known: true},
+			expectedError: `aws_vpc: attribute "tags": tag policy violations: missing required tag "Environment"`,
+		},
+		"invalid value": {
+			policy:        policy,
+			data:          mockResourceData{tags: map[string]*string{"Environment": aws.String("test")}, known: true},
+			expectedError: `aws_vpc: attribute "tags": tag policy violations: tag "Environment" value "test" does not match "^(dev|prod)$"`,
+		},
+		"invalid key case": {
+			policy:        policy,
+			data:          mockResourceData{tags: map[string]*string{"Environment": aws.String("prod"), "cost_center": aws.String("1234")}, known: true},
+			expectedError: `aws_vpc: attribute "tags": tag policy violations: tag key "cost_center" is not pascal case`,
+		},
+		"unknown value": {
+			policy: policy,
+			data:   mockResourceData{tags: map[string]*string{"Environment": nil}, known: true},
+		},
+		"unknown tags": {
+			policy: policy,
+			data:   mockResourceData{},
+		},
+		"no policy": {
+			data: mockResourceData{tags: map[string]*string{}, known: true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewContext(ctx, defaultConfig, nil, testCase.policy)
+			request := interceptors.ResourceRequest{
+				TypeName: "aws_vpc",
+				When:     interceptors.Before,
+				Why:      interceptors.ModifyPlan,
+				Data:     testCase.data,
+			}
+
+			_, err := PolicyResourceInterceptor(names.EC2).Interceptor(ctx, request)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error %q, got none", testCase.expectedError)
+			} else if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// KeyCase is a casing rule for tag keys.
+type KeyCase string
+
+const (
+	KeyCaseCamel  KeyCase = "camel"
+	KeyCaseKebab  KeyCase = "kebab"
+	KeyCaseLower  KeyCase = "lower"
+	KeyCasePascal KeyCase = "pascal"
+	KeyCaseSnake  KeyCase = "snake"
+	KeyCaseUpper  KeyCase = "upper"
+)
+
+func (KeyCase) Values() []KeyCase {
+	return []KeyCase{
+		KeyCaseCamel,
+		KeyCaseKebab,
+		KeyCaseLower,
+		KeyCasePascal,
+		KeyCaseSnake,
+		KeyCaseUpper,
+	}
+}
+
+// Match returns whether the specified tag key satisfies the casing rule.
+func (c KeyCase) Match(key string) bool {
+	switch c {
+	case KeyCaseCamel:
+		return regexache.MustCompile(`^[a-z][0-9A-Za-z]*$`).MatchString(key)
+	case KeyCaseKebab:
+		return regexache.MustCompile(`^[0-9a-z]+(-[0-9a-z]+)*$`).MatchString(key)
+	case KeyCaseLower:
+		return key == strings.ToLower(key)
+	case KeyCasePascal:
+		return regexache.MustCompile(`^[A-Z][0-9A-Za-z]*$`).MatchString(key)
+	case KeyCaseSnake:
+		return regexache.MustCompile(`^[0-9a-z]+(_[0-9a-z]+)*$`).MatchString(key)
+	case KeyCaseUpper:
+		return key == strings.ToUpper(key)
+	default:
+		return true
+	}
+}
+
+// PolicyConfig contains rules that all resource tags must satisfy.
+type PolicyConfig struct {
+	RequiredKeys  []string
+	AllowedValues map[string]*regexp.Regexp // Tag key -> pattern that the tag's value must match.
+	KeyCase       KeyCase
+}
+
+// Violations returns a sorted description of each violation of the policy by the specified tags.
+// Tags with no value data, for example those whose values are not yet known, are only checked for presence and key casing.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, k := range pc.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			violations = append(violations, fmt.Sprintf("missing required tag %q", k))
+		}
+	}
+
+	for _, k := range tags.Keys() {
+		if pc.KeyCase != "" && !pc.KeyCase.Match(k) {
+			violations = append(violations, fmt.Sprintf("tag key %q is not %s case", k, pc.KeyCase))
+		}
+
+		if re, ok := pc.AllowedValues[k]; ok {
+			if td := tags[k]; td != nil && td.Value != nil && !re.MatchString(*td.Value) {
+				violations = append(violations, fmt.Sprintf("tag %q value %q does not match %q", k, *td.Value, re.String()))
+			}
+		}
+	}
+
+	slices.Sort(violations)
+
+	return violations
+}
+
+// Validate returns an error describing any violations of the policy by the specified tags.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if violations := pc.Violations(tags); len(violations) > 0 {
+		return fmt.Errorf("tag policy violations: %s", strings.Join(violations, ", "))
+	}
+
+	return nil
+}
+
+// ValidateResource validates a resource's configured tags, merged with any provider configured default_tags, against the policy.
+// System tags are not validated. Any error names the resource type and its tags attribute.
+// Both Plugin SDK v2 and Plugin Framework resources are validated using this function, via PolicyResourceInterceptor.
+func (pc *PolicyConfig) ValidateResource(typeName, servicePackageName string, defaultConfig *DefaultConfig, configTags KeyValueTags) error {
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := defaultConfig.MergeTags(configTags)
+	// Remove system tags.
+	tags = tags.IgnoreSystem(servicePackageName)
+
+	if err := pc.Validate(tags); err != nil {
+		return fmt.Errorf("%s: attribute %q: %w", typeName, names.AttrTags, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestKeyCaseMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		keyCase  KeyCase
+		key      string
+		expected bool
+	}{
+		{KeyCaseCamel, "costCenter", true},
+		{KeyCaseCamel, "CostCenter", false},
+		{KeyCaseKebab, "cost-center", true},
+		{KeyCaseKebab, "cost_center", false},
+		{KeyCaseLower, "costcenter", true},
+		{KeyCaseLower, "CostCenter", false},
+		{KeyCasePascal, "CostCenter", true},
+		{KeyCasePascal, "costCenter", false},
+		{KeyCaseSnake, "cost_center", true},
+		{KeyCaseSnake, "cost-center", false},
+		{KeyCaseUpper, "COST_CENTER", true},
+		{KeyCaseUpper, "Cost_Center", false},
+		{"", "anything goes", true},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := testCase.keyCase.Match(testCase.key), testCase.expected; got != want {
+			t.Errorf("KeyCase(%q).Match(%q) = %t, want %t", testCase.keyCase, testCase.key, got, want)
+		}
+	}
+}
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &PolicyConfig{
+		RequiredKeys: []string{"Environment", "Owner"},
+		AllowedValues: map[string]*regexp.Regexp{
+			"Environment": regexp.MustCompile(`^(dev|prod)$`),
+		},
+		KeyCase: KeyCasePascal,
+	}
+
+	testCases := map[string]struct {
+		policy   *PolicyConfig
+		tags     KeyValueTags
+		expected []string
+	}{
+		"no policy": {
+			tags: New(ctx, map[string]string{"key": "value"}),
+		},
+		"compliant": {
+			policy: policy,
+			tags:   New(ctx, map[string]string{"Environment": "dev", "Owner": "platform"}),
+		},
+		"missing keys": {
+			policy: policy,
+			tags:   New(ctx, map[string]string{"Environment": "prod"}),
+			expected: []string{
+				`missing required tag "Owner"`,
+			},
+		},
+		"multiple violations": {
+			policy: policy,
+			tags:   New(ctx, map[string]string{"Environment": "staging", "cost_center": "1234"}),
+			expected: []string{
+				`missing required tag "Owner"`,
+				`tag "Environment" value "staging" does not match "^(dev|prod)$"`,
+				`tag key "cost_center" is not pascal case`,
+			},
+		},
+		"unknown value": {
+			policy: policy,
+			tags: KeyValueTags{
+				"Environment": &TagData{},
+				"Owner":       &TagData{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.Violations(testCase.tags)
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("Violations() = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigValidateResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &PolicyConfig{
+		RequiredKeys: []string{"Environment", "Owner"},
+		KeyCase:      KeyCasePascal,
+	}
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{"Owner": "platform"}),
+	}
+
+	testCases := map[string]struct {
+		tags          KeyValueTags
+		expectedError string
+	}{
+		"compliant with default tags": {
+			tags: New(ctx, map[string]string{"Environment": "dev"}),
+		},
+		"system tags ignored": {
+			tags: New(ctx, map[string]string{"Environment": "dev", "aws:cloudformation:stack-name": "test"}),
+		},
+		"violation": {
+			tags:          New(ctx, map[string]string{"environment": "dev"}),
+			expectedError: `aws_vpc: attribute "tags": tag policy violations: missing required tag "Environment", tag key "environment" is not pascal case`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := policy.ValidateResource("aws_vpc", names.EC2, defaultConfig, testCase.tags)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error %q, got none", testCase.expectedError)
+			} else if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -52,3 +52,65 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRBlocksOverlap returns whether or not two CIDR blocks overlap:
+// - Both CIDR blocks parse to an IP address and network
+// - Either network contains the other network's address
+func CIDRBlocksOverlap(cidr1, cidr2 string) (bool, error) {
+	_, ipnet1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %w", cidr1, err)
+	}
+	_, ipnet2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a valid CIDR block: %w", cidr2, err)
+	}
+
+	return ipnet1.Contains(ipnet2.IP) || ipnet2.Contains(ipnet1.IP), nil
+}
+
+// CIDRSubnet calculates a subnet address within the specified CIDR block.
+// newbits is the number of additional bits with which to extend the prefix and
+// netnum is the whole number identifying the subnet within the extended prefix.
+// It behaves like Terraform's built-in cidrsubnet function.
+func CIDRSubnet(cidr string, newbits, netnum int) (string, error) {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	ones, bits := ipnet.Mask.Size()
+	if newbits < 0 {
+		return "", fmt.Errorf("newbits (%d) must not be negative", newbits)
+	}
+	if ones+newbits > bits {
+		return "", fmt.Errorf("insufficient address space to extend prefix of %d by %d", ones, newbits)
+	}
+	if netnum < 0 || (newbits < 63 && netnum >= 1<<newbits) {
+		return "", fmt.Errorf("prefix extension of %d does not accommodate a subnet numbered %d", newbits, netnum)
+	}
+
+	// The address must have the same length as the mask that ones and bits were calculated from.
+	// An IPv4-mapped IPv6 CIDR block has a 16-byte mask.
+	ip := ipnet.IP.To16()
+	if len(ipnet.Mask) == net.IPv4len {
+		ip = ipnet.IP.To4()
+	}
+	ip = append(net.IP(nil), ip...)
+
+	// Set the newbits bits of netnum immediately after the existing prefix.
+	for i := 0; i < newbits; i++ {
+		if netnum&(1<<(newbits-1-i)) == 0 {
+			continue
+		}
+		pos := ones + i
+		ip[pos/8] |= 1 << (7 - pos%8)
+	}
+
+	subnet := net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(ones+newbits, bits),
+	}
+
+	return subnet.String(), nil
+}
@@ -72,3 +72,71 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlocksOverlap(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr1   string
+		cidr2   string
+		overlap bool
+		err     bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true, false},
+		{"10.0.1.0/24", "10.0.0.0/16", true, false},
+		{"10.0.0.0/24", "10.0.1.0/24", false, false},
+		{"10.0.0.0/24", "10.0.0.0/24", true, false},
+		{"2001:db8::/32", "2001:db8:1::/48", true, false},
+		{"2001:db8::/48", "2001:db8:1::/48", false, false},
+		{"10.0.0.0/1234", "10.0.0.0/24", false, true},
+		{"10.0.0.0/24", "", false, true},
+	} {
+		overlap, err := CIDRBlocksOverlap(ts.cidr1, ts.cidr2)
+		if ts.err && err == nil {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should error but didn't!", ts.cidr1, ts.cidr2)
+		}
+		if !ts.err && err != nil {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) got unexpected error: %s", ts.cidr1, ts.cidr2, err)
+		}
+		if ts.overlap != overlap {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should be: %t", ts.cidr1, ts.cidr2, ts.overlap)
+		}
+	}
+}
+
+func TestCIDRSubnet(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr     string
+		newbits  int
+		netnum   int
+		expected string
+		err      bool
+	}{
+		{"10.0.0.0/16", 8, 0, "10.0.0.0/24", false},
+		{"10.0.0.0/16", 8, 2, "10.0.2.0/24", false},
+		{"10.0.0.0/16", 2, 3, "10.0.192.0/18", false},
+		{"10.0.0.0/16", 0, 0, "10.0.0.0/16", false},
+		{"10.0.0.0/30", 4, 0, "", true},
+		{"10.0.0.0/16", 2, 4, "", true},
+		{"10.0.0.0/16", -1, 0, "", true},
+		{"2001:db8::/56", 8, 1, "2001:db8:0:1::/64", false},
+		{"2001:db8::/56", 8, 255, "2001:db8:0:ff::/64", false},
+		{"::ffff:10.0.0.0/104", 8, 1, "10.1.0.0/16", false},
+		{"::ffff:10.0.0.0/104", 24, 1, "10.0.0.1/32", false},
+		{"::ffff:10.0.0.0/104", 25, 0, "", true},
+		{"", 8, 0, "", true},
+	} {
+		got, err := CIDRSubnet(ts.cidr, ts.newbits, ts.netnum)
+		if ts.err && err == nil {
+			t.Fatalf("CIDRSubnet(%q, %d, %d) should error but didn't!", ts.cidr, ts.newbits, ts.netnum)
+		}
+		if !ts.err && err != nil {
+			t.Fatalf("CIDRSubnet(%q, %d, %d) got unexpected error: %s", ts.cidr, ts.newbits, ts.netnum, err)
+		}
+		if ts.expected != got {
+			t.Fatalf("CIDRSubnet(%q, %d, %d) should be: %q, got: %q", ts.cidr, ts.newbits, ts.netnum, ts.expected, got)
+		}
+	}
+}
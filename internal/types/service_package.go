@@ -7,8 +7,10 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptors"
 )
 
 // ServicePackageResourceTags represents resource-level tagging information.
@@ -17,6 +19,13 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageConcurrencyLimit represents a limit on the number of concurrent calls to an AWS API operation
+// implemented by a service package.
+type ServicePackageConcurrencyLimit struct {
+	Operation string // The AWS API operation name, e.g. CreateCluster.
+	Limit     int    // The maximum number of concurrent calls. A value less than 1 means unlimited.
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -25,12 +34,20 @@ type ServicePackageFrameworkDataSource struct {
 	Tags    *ServicePackageResourceTags
 }
 
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
+	Name    string
+}
+
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory      func(context.Context) (resource.ResourceWithConfigure, error)
+	Name         string
+	Tags         *ServicePackageResourceTags
+	Interceptors interceptors.ResourceInterceptors // Resource-specific interceptors, run after any provider-wide interceptors.
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -45,8 +62,9 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory      func() *schema.Resource
+	TypeName     string
+	Name         string
+	Tags         *ServicePackageResourceTags
+	Interceptors interceptors.ResourceInterceptors // Resource-specific interceptors, run after any provider-wide interceptors.
 }
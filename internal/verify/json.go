@@ -12,47 +12,41 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
 // two JSON strings representing IAM policies and returns `true` if they are semantically equivalent.
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
-	return PolicyStringsEquivalent(old, new)
-}
-
-func PolicyStringsEquivalent(s1, s2 string) bool {
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
-		return true
+	if !PolicyStringsEquivalent(old, new) {
+		return false
 	}
 
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
+	logging.LogSuppressedDiff(k, "equivalent IAM policies", old, new, iampolicy.Canonicalize)
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
-	if err != nil {
-		return false
-	}
+	return true
+}
 
-	return equivalent
+// PolicyStringsEquivalent returns whether two JSON strings representing IAM policies are equivalent.
+// See iampolicy.Canonicalize for the forms of a policy that are equivalent.
+func PolicyStringsEquivalent(s1, s2 string) bool {
+	return iampolicy.StringsEquivalent(s1, s2)
 }
 
 // SuppressEquivalentJSONDiffs returns a difference suppression function that compares
 // two JSON strings and returns `true` if they are semantically equivalent.
 func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
-	return JSONStringsEqual(old, new)
+	if !JSONStringsEqual(old, new) {
+		return false
+	}
+
+	logging.LogSuppressedDiff(k, "equivalent JSON", old, new, tfjson.Normalize)
+
+	return true
 }
 
 // SuppressEquivalentJSONWithEmptyDiffs returns a difference suppression function that compares
@@ -61,9 +55,17 @@ func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) boo
 // This is useful for suppressing diffs for non-IAM JSON policy documents.
 func SuppressEquivalentJSONWithEmptyDiffs(k, old, new string, d *schema.ResourceData) bool {
 	if old, new := strings.TrimSpace(old), strings.TrimSpace(new); (old == "" || old == "{}") && (new == "" || new == "{}") {
+		logging.LogSuppressedDiff(k, "empty JSON", old, new, nil)
 		return true
 	}
-	return JSONStringsEqual(old, new)
+
+	if !JSONStringsEqual(old, new) {
+		return false
+	}
+
+	logging.LogSuppressedDiff(k, "equivalent JSON", old, new, tfjson.Normalize)
+
+	return true
 }
 
 func SuppressEquivalentJSONOrYAMLDiffs(k, old, new string, d *schema.ResourceData) bool {
@@ -81,7 +83,13 @@ func SuppressEquivalentJSONOrYAMLDiffs(k, old, new string, d *schema.ResourceDat
 		return false
 	}
 
-	return normalizedOld == normalizedNew
+	if normalizedOld != normalizedNew {
+		return false
+	}
+
+	logging.LogSuppressedDiff(k, "equivalent JSON or YAML", old, new, normalizeJSONOrYAML)
+
+	return true
 }
 
 func NormalizeJSONOrYAMLString(templateString interface{}) (string, error) {
@@ -142,7 +150,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := iampolicy.Equivalent(old, new)
 
 	if err != nil {
 		return "", err
@@ -223,6 +231,16 @@ func SuppressEquivalentJSONRemovingFieldsDiffs(fields ...string) schema.SchemaDi
 
 		old, new = tfjson.RemoveFields(old, fields...), tfjson.RemoveFields(new, fields...)
 
-		return JSONStringsEqual(old, new)
+		if !JSONStringsEqual(old, new) {
+			return false
+		}
+
+		logging.LogSuppressedDiff(k, fmt.Sprintf("equivalent JSON without fields %v", fields), old, new, tfjson.Normalize)
+
+		return true
 	}
 }
+
+func normalizeJSONOrYAML(s string) (string, error) {
+	return NormalizeJSONOrYAMLString(s)
+}
@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel reads the shapes of AWS API operations from the source code
+// of an AWS SDK for Go v2 service package.
+package apimodel
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	sdkServicePackagePrefix = "github.com/aws/aws-sdk-go-v2/service/"
+	requiredMemberComment   = "This member is required."
+)
+
+// Kind is the kind of an API type.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindBool
+	KindInteger
+	KindFloat
+	KindString
+	KindEnum
+	KindTimestamp
+	KindStructure
+	KindUnion
+	KindList
+	KindMap
+)
+
+// Type is an API type.
+type Type struct {
+	Kind Kind
+	// Name is the name of an enum, structure or union type in the service's types package.
+	Name string
+	// Elem is the element type of a list or map.
+	Elem *Type
+	// Expr is the Go type expression, for use in diagnostics.
+	Expr string
+}
+
+// IsNested returns whether the type is a structure or union, or a list of structures or unions.
+func (t *Type) IsNested() bool {
+	switch t.Kind {
+	case KindStructure, KindUnion:
+		return true
+	case KindList:
+		return t.Elem.IsNested()
+	}
+
+	return false
+}
+
+// Member is a member of an API shape.
+type Member struct {
+	Name     string
+	Type     *Type
+	Required bool
+	Doc      string
+}
+
+// Shape is an API structure.
+type Shape struct {
+	Name    string
+	Members []*Member
+}
+
+// Member returns the member with the specified name, or nil.
+func (s *Shape) Member(name string) *Member {
+	for _, m := range s.Members {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// Service is an AWS SDK for Go v2 service package.
+type Service struct {
+	// Package is the name of the SDK service package, e.g. "cloudfront".
+	Package string
+
+	operations map[string]bool
+	paginators map[string]bool
+	shapes     map[string]*ast.StructType // Operation inputs and outputs.
+	types      map[string]ast.Expr        // Declarations in the types package.
+	enumValues map[string][]EnumValue
+	errors     []string
+}
+
+// EnumValue is a value of an enum type.
+type EnumValue struct {
+	Name  string // The Go constant name, e.g. "WidgetStatusActive".
+	Value string
+}
+
+// Load reads the AWS SDK for Go v2 service package with the specified name.
+// The package is located using the Go module containing srcDir.
+func Load(pkg, srcDir string) (*Service, error) {
+	p, err := build.Import(sdkServicePackagePrefix+pkg, srcDir, build.FindOnly)
+	if err != nil {
+		return nil, fmt.Errorf("locating AWS SDK for Go v2 package (%s): %w", pkg, err)
+	}
+
+	return LoadDir(pkg, p.Dir)
+}
+
+// LoadDir reads the AWS SDK for Go v2 service package with the specified name from dir.
+func LoadDir(pkg, dir string) (*Service, error) {
+	s := &Service{
+		Package:    pkg,
+		operations: make(map[string]bool),
+		paginators: make(map[string]bool),
+		shapes:     make(map[string]*ast.StructType),
+		types:      make(map[string]ast.Expr),
+		enumValues: make(map[string][]EnumValue),
+	}
+
+	files, err := parseDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, f := range files {
+		if !strings.HasPrefix(filepath.Base(name), "api_op_") {
+			continue
+		}
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil {
+					if isClientMethod(decl) {
+						s.operations[decl.Name.Name] = true
+					}
+					continue
+				}
+				if v, ok := strings.CutPrefix(decl.Name.Name, "New"); ok && strings.HasSuffix(v, "Paginator") {
+					s.paginators[strings.TrimSuffix(v, "Paginator")] = true
+				}
+
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if v, ok := spec.Type.(*ast.StructType); ok {
+							s.shapes[spec.Name.Name] = v
+						}
+					}
+				}
+			}
+		}
+	}
+
+	files, err = parseDir(filepath.Join(dir, "types"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil && decl.Name.Name == "ErrorCode" {
+					if name := receiverTypeName(decl); name != "" {
+						s.errors = append(s.errors, name)
+					}
+				}
+
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						s.types[spec.Name.Name] = spec.Type
+
+					case *ast.ValueSpec:
+						if decl.Tok != token.CONST || spec.Type == nil || len(spec.Values) != len(spec.Names) {
+							continue
+						}
+						typ, ok := spec.Type.(*ast.Ident)
+						if !ok {
+							continue
+						}
+						for i, name := range spec.Names {
+							if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+								v, err := strconv.Unquote(lit.Value)
+								if err != nil {
+									continue
+								}
+								s.enumValues[typ.Name] = append(s.enumValues[typ.Name], EnumValue{Name: name.Name, Value: v})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	sort.Strings(s.errors)
+
+	return s, nil
+}
+
+func parseDir(dir string) (map[string]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory (%s): %w", dir, err)
+	}
+
+	files := make(map[string]*ast.File)
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		files[path] = f
+	}
+
+	return files, nil
+}
+
+// isClientMethod returns whether the function is an API operation method on *Client.
+func isClientMethod(decl *ast.FuncDecl) bool {
+	return receiverTypeName(decl) == "Client" && decl.Name.IsExported()
+}
+
+func receiverTypeName(decl *ast.FuncDecl) string {
+	if len(decl.Recv.List) != 1 {
+		return ""
+	}
+
+	expr := decl.Recv.List[0].Type
+	if v, ok := expr.(*ast.StarExpr); ok {
+		expr = v.X
+	}
+
+	if v, ok := expr.(*ast.Ident); ok {
+		return v.Name
+	}
+
+	return ""
+}
+
+// HasOperation returns whether the service has the named API operation.
+func (s *Service) HasOperation(name string) bool {
+	return s.operations[name]
+}
+
+// HasPaginator returns whether the service has a paginator for the named API operation.
+func (s *Service) HasPaginator(name string) bool {
+	return s.paginators[name]
+}
+
+// Input returns the input shape of the named API operation.
+func (s *Service) Input(operation string) (*Shape, error) {
+	return s.operationShape(operation, "Input")
+}
+
+// Output returns the output shape of the named API operation.
+func (s *Service) Output(operation string) (*Shape, error) {
+	return s.operationShape(operation, "Output")
+}
+
+func (s *Service) operationShape(operation, suffix string) (*Shape, error) {
+	if !s.HasOperation(operation) {
+		return nil, fmt.Errorf("operation (%s) not found in AWS SDK for Go v2 package (%s)", operation, s.Package)
+	}
+
+	name := operation + suffix
+	v, ok := s.shapes[name]
+	if !ok {
+		return nil, fmt.Errorf("type (%s) not found in AWS SDK for Go v2 package (%s)", name, s.Package)
+	}
+
+	return s.newShape(name, v, false), nil
+}
+
+// Structure returns the shape of the named structure type in the service's types package.
+func (s *Service) Structure(name string) (*Shape, error) {
+	v, ok := s.types[name].(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("structure (%s) not found in AWS SDK for Go v2 package (%s/types)", name, s.Package)
+	}
+
+	return s.newShape(name, v, true), nil
+}
+
+// UnionMembers returns the names of the member types of the named union type, e.g. "PolicyDefinitionMemberStatic".
+func (s *Service) UnionMembers(name string) []string {
+	var members []string
+
+	for k, v := range s.types {
+		if _, ok := v.(*ast.StructType); ok && strings.HasPrefix(k, name+"Member") {
+			members = append(members, k)
+		}
+	}
+
+	sort.Strings(members)
+
+	return members
+}
+
+// EnumValues returns the values of the named enum type.
+func (s *Service) EnumValues(name string) []EnumValue {
+	return s.enumValues[name]
+}
+
+// NotFoundError returns the name of the error type returned when a resource isn't found,
+// or "" if the service has no such error type.
+func (s *Service) NotFoundError() string {
+	for _, v := range []string{"ResourceNotFoundException", "ResourceNotFoundFault", "NotFoundException"} {
+		for _, err := range s.errors {
+			if err == v {
+				return err
+			}
+		}
+	}
+
+	for _, err := range s.errors {
+		if strings.Contains(err, "NotFound") {
+			return err
+		}
+	}
+
+	return ""
+}
+
+func (s *Service) newShape(name string, v *ast.StructType, inTypes bool) *Shape {
+	shape := &Shape{
+		Name: name,
+	}
+
+	for _, field := range v.Fields.List {
+		for _, ident := range field.Names {
+			if !ident.IsExported() || ident.Name == "ResultMetadata" {
+				continue
+			}
+
+			doc := field.Doc.Text()
+			shape.Members = append(shape.Members, &Member{
+				Name:     ident.Name,
+				Type:     s.resolveType(field.Type, inTypes),
+				Required: strings.Contains(doc, requiredMemberComment),
+				Doc:      doc,
+			})
+		}
+	}
+
+	return shape
+}
+
+// resolveType returns the API type of a Go type expression.
+// inTypes indicates whether the expression is in the service's types package.
+func (s *Service) resolveType(expr ast.Expr, inTypes bool) *Type {
+	typ := &Type{
+		Expr: typeExprString(expr),
+	}
+
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		v := s.resolveType(expr.X, inTypes)
+		v.Expr = typ.Expr
+		return v
+
+	case *ast.Ident:
+		switch expr.Name {
+		case "bool":
+			typ.Kind = KindBool
+		case "int", "int32", "int64":
+			typ.Kind = KindInteger
+		case "float32", "float64":
+			typ.Kind = KindFloat
+		case "string":
+			typ.Kind = KindString
+		default:
+			if inTypes {
+				s.resolveNamedType(typ, expr.Name)
+			}
+		}
+
+	case *ast.SelectorExpr:
+		if pkg, ok := expr.X.(*ast.Ident); ok {
+			switch {
+			case pkg.Name == "types":
+				s.resolveNamedType(typ, expr.Sel.Name)
+			case pkg.Name == "time" && expr.Sel.Name == "Time":
+				typ.Kind = KindTimestamp
+			}
+		}
+
+	case *ast.ArrayType:
+		if v, ok := expr.Elt.(*ast.Ident); ok && v.Name == "byte" {
+			break // Blobs are not supported.
+		}
+		typ.Kind = KindList
+		typ.Elem = s.resolveType(expr.Elt, inTypes)
+
+	case *ast.MapType:
+		if v, ok := expr.Key.(*ast.Ident); !ok || v.Name != "string" {
+			break
+		}
+		typ.Kind = KindMap
+		typ.Elem = s.resolveType(expr.Value, inTypes)
+	}
+
+	return typ
+}
+
+func (s *Service) resolveNamedType(typ *Type, name string) {
+	typ.Name = name
+
+	switch v := s.types[name].(type) {
+	case *ast.StructType:
+		typ.Kind = KindStructure
+
+	case *ast.InterfaceType:
+		typ.Kind = KindUnion
+
+	case *ast.Ident:
+		switch v.Name {
+		case "string":
+			typ.Kind = KindEnum
+		case "int32", "int64":
+			typ.Kind = KindInteger
+		}
+	}
+}
+
+func typeExprString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return "*" + typeExprString(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return typeExprString(expr.X) + "." + expr.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + typeExprString(expr.Elt)
+	case *ast.MapType:
+		return "map[" + typeExprString(expr.Key) + "]" + typeExprString(expr.Value)
+	}
+
+	return fmt.Sprintf("%T", expr)
+}
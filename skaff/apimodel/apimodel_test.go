@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadDir(t *testing.T) {
+	t.Parallel()
+
+	service, err := LoadDir("widgets", "testdata/widgets")
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	if !service.HasOperation("CreateWidget") {
+		t.Error("expected operation CreateWidget")
+	}
+	if service.HasOperation("DescribeWidget") {
+		t.Error("unexpected operation DescribeWidget")
+	}
+	if !service.HasPaginator("ListWidgets") {
+		t.Error("expected paginator for ListWidgets")
+	}
+	if got, want := service.NotFoundError(), "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundError = %q, want %q", got, want)
+	}
+
+	input, err := service.Input("CreateWidget")
+	if err != nil {
+		t.Fatalf("reading input: %s", err)
+	}
+
+	testCases := []struct {
+		name     string
+		kind     Kind
+		typeName string
+		required bool
+	}{
+		{name: "Name", kind: KindString, required: true},
+		{name: "Configuration", kind: KindStructure, typeName: "Configuration"},
+		{name: "Labels", kind: KindList},
+		{name: "Tags", kind: KindMap},
+	}
+
+	if got, want := len(input.Members), len(testCases); got != want {
+		t.Fatalf("len(Members) = %d, want %d", got, want)
+	}
+
+	for _, testCase := range testCases {
+		m := input.Member(testCase.name)
+		if m == nil {
+			t.Errorf("member %s not found", testCase.name)
+			continue
+		}
+
+		if m.Type.Kind != testCase.kind {
+			t.Errorf("member %s: Kind = %d, want %d", testCase.name, m.Type.Kind, testCase.kind)
+		}
+		if m.Type.Name != testCase.typeName {
+			t.Errorf("member %s: Name = %q, want %q", testCase.name, m.Type.Name, testCase.typeName)
+		}
+		if m.Required != testCase.required {
+			t.Errorf("member %s: Required = %t, want %t", testCase.name, m.Required, testCase.required)
+		}
+	}
+
+	widget, err := service.Structure("Widget")
+	if err != nil {
+		t.Fatalf("reading structure: %s", err)
+	}
+
+	if got, want := widget.Member("Status").Type.Kind, KindEnum; got != want {
+		t.Errorf("Status: Kind = %d, want %d", got, want)
+	}
+	if got, want := widget.Member("CreatedAt").Type.Kind, KindTimestamp; got != want {
+		t.Errorf("CreatedAt: Kind = %d, want %d", got, want)
+	}
+	if got, want := len(service.EnumValues("WidgetStatus")), 3; got != want {
+		t.Errorf("len(EnumValues) = %d, want %d", got, want)
+	}
+	if got, want := service.UnionMembers("Source"), []string{"SourceMemberBucket", "SourceMemberConfiguration"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("UnionMembers = %v, want %v", got, want)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+
+	service, err := LoadDir("widgets", "testdata/widgets")
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	input, err := service.Input("CreateWidget")
+	if err != nil {
+		t.Fatalf("reading input: %s", err)
+	}
+
+	b := NewBuilder(service)
+
+	attr, ok := b.Attribute(input.Member("Configuration"))
+	if !ok {
+		t.Fatal("expected Configuration to be supported")
+	}
+
+	if !attr.IsBlock() || !attr.MaxItems1 {
+		t.Errorf("expected Configuration to be a block with at most one item")
+	}
+	if got, want := attr.TFName, "configuration"; got != want {
+		t.Errorf("TFName = %q, want %q", got, want)
+	}
+
+	if got, want := len(b.Unions), 1; got != want {
+		t.Fatalf("len(Unions) = %d, want %d", got, want)
+	}
+	// The recursive Configuration union member and the blob are not supported.
+	if got, want := len(b.Unions[0].Members), 1; got != want {
+		t.Errorf("len(Union.Members) = %d, want %d", got, want)
+	}
+	if got, want := len(b.Skipped), 2; got != want {
+		t.Errorf("Skipped = %v, want %d entries", b.Skipped, want)
+	}
+
+	attr, ok = b.Attribute(input.Member("Labels"))
+	if !ok {
+		t.Fatal("expected Labels to be supported")
+	}
+
+	if got, want := attr.CustomType, "fwtypes.ListOfStringType"; got != want {
+		t.Errorf("CustomType = %q, want %q", got, want)
+	}
+}
+
+func TestEndpointIDExpr(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join("testdata", "names", "names.go")
+
+	for service, want := range map[string]string{
+		"Events":  "names.EventsEndpointID",
+		"Widgets": "names.Widgets",
+	} {
+		if got := EndpointIDExpr(filename, service); got != want {
+			t.Errorf("EndpointIDExpr(%q) = %q, want %q", service, got, want)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+// Attribute describes a Terraform Plugin Framework attribute, or block, generated from an API member.
+type Attribute struct {
+	// Name is the name of the field in the AutoFlex model, which matches the API member name.
+	Name string
+	// TFName is the Terraform attribute name.
+	TFName string
+	// TFNameExpr is the Go expression for the Terraform attribute name, e.g. names.AttrARN.
+	TFNameExpr string
+	// ModelType is the Go type of the field in the AutoFlex model.
+	ModelType string
+	// SchemaType is the kind of schema attribute, e.g. "String" for schema.StringAttribute.
+	SchemaType string
+	// CustomType is the Go expression for the attribute's custom type, if any.
+	CustomType string
+	// ElementType is the Go expression for the element type of a List or Map attribute.
+	ElementType string
+	// SchemaExpr is a Go expression for the attribute's schema that replaces the generated one, e.g. "framework.IDAttribute()".
+	SchemaExpr string
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	// UseStateForUnknown indicates that a computed attribute's value does not change once known.
+	UseStateForUnknown bool
+
+	// Nested is the model of a block's nested object, or nil for an attribute.
+	Nested *Model
+	// MaxItems1 indicates that a block corresponds to a single API structure.
+	MaxItems1 bool
+}
+
+// IsBlock returns whether the attribute is a block.
+func (a *Attribute) IsBlock() bool {
+	return a.Nested != nil
+}
+
+// PlanModifierPackage returns the name of the plan modifier package for the attribute, e.g. "stringplanmodifier".
+func (a *Attribute) PlanModifierPackage() string {
+	if a.IsBlock() {
+		return "listplanmodifier"
+	}
+
+	return strings.ToLower(a.SchemaType) + "planmodifier"
+}
+
+// Model is an AutoFlex model for a nested object.
+type Model struct {
+	// Name is the name of the model's Go type.
+	Name       string
+	Attributes []*Attribute
+	Blocks     []*Attribute
+}
+
+// Fields returns the attributes and blocks of the model.
+func (m *Model) Fields() []*Attribute {
+	fields := make([]*Attribute, 0, len(m.Attributes)+len(m.Blocks))
+	fields = append(fields, m.Attributes...)
+	fields = append(fields, m.Blocks...)
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+
+	return fields
+}
+
+// Union is an API union that must be registered with AutoFlex.
+type Union struct {
+	Name    string
+	Members []string
+}
+
+// Builder converts API shapes into Terraform Plugin Framework attributes and AutoFlex models.
+type Builder struct {
+	service *Service
+
+	// Models are the AutoFlex models of the nested objects of all built attributes.
+	Models []*Model
+	// Unions are the API unions of all built attributes.
+	Unions []*Union
+	// Skipped lists the API members that could not be converted.
+	Skipped []string
+
+	models   map[string]*Model
+	visiting map[string]bool
+	imports  map[string]bool
+}
+
+// NewBuilder returns a new Builder for the specified service.
+func NewBuilder(service *Service) *Builder {
+	return &Builder{
+		service:  service,
+		models:   make(map[string]*Model),
+		visiting: make(map[string]bool),
+		imports:  make(map[string]bool),
+	}
+}
+
+// Imports returns the import specifications required by the built attributes, sorted.
+func (b *Builder) Imports() []string {
+	imports := make([]string, 0, len(b.imports))
+	for k := range b.imports {
+		imports = append(imports, k)
+	}
+
+	sort.Strings(imports)
+
+	return imports
+}
+
+// AddImport records an import specification required by generated code.
+func (b *Builder) AddImport(spec string) {
+	b.imports[spec] = true
+}
+
+// Attribute returns the Terraform Plugin Framework attribute for the specified API member.
+// Returns false, and records the member as skipped, if the member's type is not supported.
+func (b *Builder) Attribute(member *Member) (*Attribute, bool) {
+	tfName := convert.ToSnakeCase(member.Name, "")
+	attr := &Attribute{
+		Name:       member.Name,
+		TFName:     tfName,
+		TFNameExpr: strconv.Quote(tfName),
+	}
+
+	if !b.setType(attr, member.Type) {
+		b.Skipped = append(b.Skipped, fmt.Sprintf("%s (%s)", member.Name, member.Type.Expr))
+		return nil, false
+	}
+
+	return attr, true
+}
+
+func (b *Builder) setType(attr *Attribute, typ *Type) bool {
+	switch typ.Kind {
+	case KindBool:
+		attr.ModelType, attr.SchemaType = "types.Bool", "Bool"
+		b.AddImport(importFrameworkTypes)
+
+	case KindInteger:
+		attr.ModelType, attr.SchemaType = "types.Int64", "Int64"
+		b.AddImport(importFrameworkTypes)
+
+	case KindFloat:
+		attr.ModelType, attr.SchemaType = "types.Float64", "Float64"
+		b.AddImport(importFrameworkTypes)
+
+	case KindString:
+		attr.ModelType, attr.SchemaType = "types.String", "String"
+		b.AddImport(importFrameworkTypes)
+
+	case KindEnum:
+		attr.ModelType, attr.SchemaType = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", typ.Name), "String"
+		attr.CustomType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", typ.Name)
+		b.AddImport(importFWTypes)
+		b.AddImport(b.importAWSTypes())
+
+	case KindTimestamp:
+		attr.ModelType, attr.SchemaType = "timetypes.RFC3339", "String"
+		attr.CustomType = "timetypes.RFC3339Type{}"
+		b.AddImport(importTimeTypes)
+
+	case KindList:
+		switch typ.Elem.Kind {
+		case KindString, KindEnum:
+			attr.ModelType, attr.SchemaType = "fwtypes.ListValueOf[types.String]", "List"
+			attr.CustomType = "fwtypes.ListOfStringType"
+			attr.ElementType = "types.StringType"
+			b.AddImport(importFWTypes)
+			b.AddImport(importFrameworkTypes)
+
+		case KindStructure, KindUnion:
+			return b.setBlock(attr, typ.Elem, false)
+
+		default:
+			return false
+		}
+
+	case KindMap:
+		switch typ.Elem.Kind {
+		case KindString:
+			attr.ModelType, attr.SchemaType = "fwtypes.MapValueOf[types.String]", "Map"
+			attr.CustomType = "fwtypes.MapOfStringType"
+			attr.ElementType = "types.StringType"
+			b.AddImport(importFWTypes)
+			b.AddImport(importFrameworkTypes)
+
+		default:
+			return false
+		}
+
+	case KindStructure, KindUnion:
+		return b.setBlock(attr, typ, true)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (b *Builder) setBlock(attr *Attribute, typ *Type, maxItems1 bool) bool {
+	model, ok := b.model(typ)
+	if !ok {
+		return false
+	}
+
+	attr.Nested = model
+	attr.MaxItems1 = maxItems1
+	attr.ModelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", model.Name)
+	attr.CustomType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", model.Name)
+	b.AddImport(importFWTypes)
+
+	return true
+}
+
+// model returns the AutoFlex model for the specified structure or union type.
+func (b *Builder) model(typ *Type) (*Model, bool) {
+	if v, ok := b.models[typ.Name]; ok {
+		return v, true
+	}
+
+	// Recursive shapes are not supported.
+	if b.visiting[typ.Name] {
+		return nil, false
+	}
+	b.visiting[typ.Name] = true
+	defer delete(b.visiting, typ.Name)
+
+	model := &Model{
+		Name: convert.ToLowercasePrefix(typ.Name) + "Model",
+	}
+
+	var union *Union
+	var members []*Member
+	var unionMembers []string // The union member type of each member.
+	if typ.Kind == KindUnion {
+		union = &Union{
+			Name: typ.Name,
+		}
+
+		for _, name := range b.service.UnionMembers(typ.Name) {
+			shape, err := b.service.Structure(name)
+			if err != nil {
+				continue
+			}
+			value := shape.Member("Value")
+			if value == nil {
+				continue
+			}
+
+			members = append(members, &Member{
+				Name: strings.TrimPrefix(name, typ.Name+"Member"),
+				Type: value.Type,
+			})
+			unionMembers = append(unionMembers, name)
+		}
+	} else {
+		shape, err := b.service.Structure(typ.Name)
+		if err != nil {
+			return nil, false
+		}
+
+		members = shape.Members
+	}
+
+	for i, member := range members {
+		attr, ok := b.Attribute(member)
+		if !ok {
+			continue
+		}
+
+		if union != nil {
+			union.Members = append(union.Members, unionMembers[i])
+		}
+
+		// Exactly one member of a union is configured.
+		if member.Required && union == nil {
+			attr.Required = true
+		} else {
+			attr.Optional = true
+		}
+
+		if attr.IsBlock() {
+			model.Blocks = append(model.Blocks, attr)
+		} else {
+			model.Attributes = append(model.Attributes, attr)
+		}
+	}
+
+	if union != nil {
+		if len(union.Members) == 0 {
+			return nil, false
+		}
+
+		b.Unions = append(b.Unions, union)
+		b.AddImport(importFWFlex)
+		b.AddImport(b.importAWSTypes())
+	}
+
+	b.models[typ.Name] = model
+	b.Models = append(b.Models, model)
+
+	return model, true
+}
+
+func (b *Builder) importAWSTypes() string {
+	return fmt.Sprintf("awstypes %q", sdkServicePackagePrefix+b.service.Package+"/types")
+}
+
+const (
+	importFrameworkTypes = `"github.com/hashicorp/terraform-plugin-framework/types"`
+	importFWFlex         = `fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`
+	importFWTypes        = `fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`
+	importTimeTypes      = `"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"`
+)
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+)
+
+// EndpointIDExpr returns the Go expression for the service's endpoint ID, given the path of the names package's names.go.
+// Not all services declare an endpoint ID constant, in which case the generated service package name constant is used.
+func EndpointIDExpr(filename, service string) string {
+	if constName := service + "EndpointID"; declaresConst(filename, constName) {
+		return "names." + constName
+	}
+
+	return "names." + service
+}
+
+// declaresConst returns whether the specified file declares a constant with the specified name.
+// Returns false if the file cannot be parsed.
+func declaresConst(filename, name string) bool {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return false
+	}
+
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			spec, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for _, v := range spec.Names {
+				if v.Name == name {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,35 @@
+package widgets
+
+import (
+	"context"
+
+	"example.com/widgets/types"
+)
+
+func (c *Client) CreateWidget(ctx context.Context, params *CreateWidgetInput) (*CreateWidgetOutput, error) {
+	return nil, nil
+}
+
+type CreateWidgetInput struct {
+
+	// The widget's name.
+	//
+	// This member is required.
+	Name *string
+
+	Configuration *types.Configuration
+
+	Labels []string
+
+	Tags map[string]string
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+	Widget *types.Widget
+
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
@@ -0,0 +1,23 @@
+package widgets
+
+import (
+	"context"
+)
+
+func (c *Client) DeleteWidget(ctx context.Context, params *DeleteWidgetInput) (*DeleteWidgetOutput, error) {
+	return nil, nil
+}
+
+type DeleteWidgetInput struct {
+
+	// This member is required.
+	WidgetId *string
+
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetOutput struct {
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
@@ -0,0 +1,27 @@
+package widgets
+
+import (
+	"context"
+
+	"example.com/widgets/types"
+)
+
+func (c *Client) GetWidget(ctx context.Context, params *GetWidgetInput) (*GetWidgetOutput, error) {
+	return nil, nil
+}
+
+type GetWidgetInput struct {
+
+	// This member is required.
+	WidgetId *string
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+	Widget *types.Widget
+
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
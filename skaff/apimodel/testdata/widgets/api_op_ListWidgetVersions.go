@@ -0,0 +1,27 @@
+package widgets
+
+import (
+	"context"
+)
+
+func (c *Client) ListWidgetVersions(ctx context.Context, params *ListWidgetVersionsInput) (*ListWidgetVersionsOutput, error) {
+	return nil, nil
+}
+
+type ListWidgetVersionsInput struct {
+
+	// This member is required.
+	WidgetId *string
+
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetVersionsOutput struct {
+	NextToken *string
+
+	Widgets []types.WidgetSummary
+
+	noSmithyDocumentSerde
+}
@@ -0,0 +1,27 @@
+package widgets
+
+import (
+	"context"
+)
+
+func (c *Client) ListWidgets(ctx context.Context, params *ListWidgetsInput) (*ListWidgetsOutput, error) {
+	return nil, nil
+}
+
+type ListWidgetsInput struct {
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsOutput struct {
+	NextToken *string
+
+	Widgets []types.WidgetSummary
+
+	noSmithyDocumentSerde
+}
+
+func NewListWidgetsPaginator(client ListWidgetsAPIClient, params *ListWidgetsInput) *ListWidgetsPaginator {
+	return nil
+}
@@ -0,0 +1,25 @@
+package widgets
+
+import (
+	"context"
+)
+
+func (c *Client) UpdateWidget(ctx context.Context, params *UpdateWidgetInput) (*UpdateWidgetOutput, error) {
+	return nil, nil
+}
+
+type UpdateWidgetInput struct {
+
+	// This member is required.
+	WidgetId *string
+
+	Labels []string
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetOutput struct {
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
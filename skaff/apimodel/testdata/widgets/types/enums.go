@@ -0,0 +1,10 @@
+package types
+
+type WidgetStatus string
+
+// Enum values for WidgetStatus
+const (
+	WidgetStatusCreating WidgetStatus = "CREATING"
+	WidgetStatusActive   WidgetStatus = "ACTIVE"
+	WidgetStatusDeleting WidgetStatus = "DELETING"
+)
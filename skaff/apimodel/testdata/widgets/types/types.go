@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+)
+
+type Configuration struct {
+
+	// This member is required.
+	Size *int32
+
+	Source Source
+
+	Data []byte
+
+	noSmithyDocumentSerde
+}
+
+type Widget struct {
+	Arn *string
+
+	CreatedAt *time.Time
+
+	Name *string
+
+	Status WidgetStatus
+
+	WidgetId *string
+
+	noSmithyDocumentSerde
+}
+
+type WidgetSummary struct {
+	Name *string
+
+	noSmithyDocumentSerde
+}
+
+type Source interface {
+	isSource()
+}
+
+type SourceMemberBucket struct {
+	Value string
+
+	noSmithyDocumentSerde
+}
+
+func (*SourceMemberBucket) isSource() {}
+
+type SourceMemberConfiguration struct {
+	Value Configuration
+
+	noSmithyDocumentSerde
+}
+
+func (*SourceMemberConfiguration) isSource() {}
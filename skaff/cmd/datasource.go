@@ -4,14 +4,29 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/datasource"
 	"github.com/spf13/cobra"
 )
 
+var (
+	plural        bool
+	pluralOptions datasource.PluralOptions
+)
+
 var datasourceCmd = &cobra.Command{
 	Use:   "datasource",
 	Short: "Create scaffolding for a data source",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if plural {
+			if v1 {
+				return fmt.Errorf("error checking: plural data sources require AWS SDK for Go v2")
+			}
+
+			return datasource.CreatePlural(name, snakeName, force, !pluginSDKV2, pluralOptions)
+		}
+
 		return datasource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -25,4 +40,8 @@ func init() {
 	datasourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	datasourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	datasourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	datasourceCmd.Flags().BoolVar(&plural, "plural", false, "generate a plural data source that lists resources, using the AWS SDK for Go v2 API model (e.g., for name Widgets)")
+	datasourceCmd.Flags().StringVar(&pluralOptions.ListOperation, "list-op", "", "list operation used with --plural (default List<name>)")
+	datasourceCmd.Flags().StringVar(&pluralOptions.SDKPackage, "sdk-package", "", "AWS SDK for Go v2 service package used with --plural, if different from the provider's service package")
+	datasourceCmd.Flags().BoolVar(&pluralOptions.ListPages, "list-pages", false, "use a function generated by internal/generate/listpages with --plural, even if the AWS SDK defines a paginator")
 }
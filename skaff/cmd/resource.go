@@ -16,12 +16,17 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	apiModel      resource.APIModelOptions
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiModel.CreateOperation != "" || apiModel.ReadOperation != "" || apiModel.DeleteOperation != "" {
+			return resource.CreateFromAPIModel(name, snakeName, force, includeTags, apiModel)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +40,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&apiModel.CreateOperation, "create-op", "", "generate the resource from the AWS SDK for Go v2 API model, using this create operation (e.g., CreateWidget)")
+	resourceCmd.Flags().StringVar(&apiModel.ReadOperation, "read-op", "", "read operation used with --create-op (e.g., DescribeWidget)")
+	resourceCmd.Flags().StringVar(&apiModel.UpdateOperation, "update-op", "", "optional update operation used with --create-op (e.g., UpdateWidget)")
+	resourceCmd.Flags().StringVar(&apiModel.DeleteOperation, "delete-op", "", "delete operation used with --create-op (e.g., DeleteWidget)")
+	resourceCmd.Flags().StringVar(&apiModel.ListOperation, "list-op", "", "optional list operation used by the sweeper generated with --create-op (default List<name>s)")
+	resourceCmd.Flags().StringVar(&apiModel.SDKPackage, "sdk-package", "", "AWS SDK for Go v2 service package used with --create-op, if different from the provider's service package")
 }
@@ -8,6 +8,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -55,36 +56,66 @@ func Create(dsName, snakeName string, comments, force, v2, pluginFramework, tags
 
 	servicePackage := filepath.Base(wd)
 
+	templateData, err := newTemplateData(servicePackage, dsName, snakeName, comments, tags, v2, pluginFramework)
+	if err != nil {
+		return err
+	}
+	snakeName = templateData.DataSourceSnake
+
+	tmpl := datasourceTmpl
+	if pluginFramework {
+		tmpl = datasourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s_data_source.go", snakeName)
+	if err = writeTemplate("newds", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing datasource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_data_source_test.go", snakeName)
+	if err = writeTemplate("dstest", tf, datasourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing datasource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing datasource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(servicePackage, dsName, snakeName string, comments, tags, v2, pluginFramework bool) (TemplateData, error) {
 	if dsName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if dsName == strings.ToLower(dsName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = convert.ToSnakeCase(dsName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
-	templateData := TemplateData{
+	return TemplateData{
 		DataSource:           dsName,
 		DataSourceLower:      strings.ToLower(dsName),
 		DataSourceSnake:      snakeName,
@@ -99,59 +130,59 @@ func Create(dsName, snakeName string, comments, force, v2, pluginFramework, tags
 		PluginFramework:      pluginFramework,
 		HumanDataSourceName:  convert.ToHumanResName(dsName),
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
-	}
-
-	tmpl := datasourceTmpl
-	if pluginFramework {
-		tmpl = datasourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s_data_source.go", snakeName)
-	if err = writeTemplate("newds", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing datasource template: %w", err)
-	}
-
-	tf := fmt.Sprintf("%s_data_source_test.go", snakeName)
-	if err = writeTemplate("dstest", tf, datasourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing datasource test template: %w", err)
-	}
+	}, nil
+}
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing datasource website doc template: %w", err)
+func writeTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return writeFile(filename, contents, force)
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+// writeGoTemplate is like writeTemplate, but formats the generated Go source.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	v, err := format.Source(contents)
 	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
 	}
 
+	return writeFile(filename, v, force)
+}
+
+func executeTemplate(templateName, tmpl string, td any) ([]byte, error) {
 	tplate, err := template.New(templateName).Parse(tmpl)
 	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+		return nil, fmt.Errorf("error parsing template: %s", err)
 	}
 
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeFile(filename string, contents []byte, force bool) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
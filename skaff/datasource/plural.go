@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed datasourceplural.tmpl
+var datasourcePluralTmpl string
+
+//go:embed datasourcepluraltest.tmpl
+var datasourcePluralTestTmpl string
+
+//go:embed websitedocplural.tmpl
+var websitePluralTmpl string
+
+// PluralOptions configures the generation of a plural ("list all X") data source.
+type PluralOptions struct {
+	// SDKPackage is the AWS SDK for Go v2 service package, e.g. "secretsmanager".
+	// Defaults to the provider service package's AWS SDK for Go v2 package.
+	SDKPackage string
+	// ListOperation is the AWS API operation that lists the resources, e.g. "ListSecrets".
+	// Defaults to "List<name>".
+	ListOperation string
+	// ListPages forces the use of a function generated by internal/generate/listpages,
+	// even if the AWS SDK for Go v2 defines a paginator for the list operation.
+	ListPages bool
+}
+
+type pluralTemplateData struct {
+	TemplateData
+
+	DataSourceLowerCamel string
+	// EndpointIDExpr is the Go expression for the service's endpoint ID, e.g. names.EventsEndpointID.
+	EndpointIDExpr string
+	SDKPackage     string
+	ListOperation  string
+	// ItemsField is the field of the list operation's output containing the listed items.
+	ItemsField string
+	// ItemType is the Go type of a listed item, e.g. "awstypes.SecretListEntry".
+	ItemType string
+	// Attributes are the computed attributes, each containing one value per listed item.
+	Attributes []*pluralAttribute
+	// Paginated indicates that the AWS SDK for Go v2 defines a paginator for the list operation.
+	Paginated bool
+	// ListPagesFunc is the name of the function generated by internal/generate/listpages, if any.
+	ListPagesFunc string
+	// FiltersFunc is the name of the namevaluesfiltersv2.NameValuesFilters method converting filters, if any.
+	FiltersFunc string
+	// FilterNames are the known filter names, used in examples and documentation.
+	FilterNames []string
+}
+
+type pluralAttribute struct {
+	TFName     string
+	TFNameExpr string
+	// Field is the name of the field in the data source's model.
+	Field string
+	// ValueExpr is the Go expression for an item's value, where the item is v.
+	ValueExpr string
+	// Description is the documented description of the attribute's values, e.g. "ARNs".
+	Description string
+}
+
+// NeedsAWS returns whether the generated data source uses the aws package.
+func (td *pluralTemplateData) NeedsAWS() bool {
+	for _, v := range td.Attributes {
+		if strings.HasPrefix(v.ValueExpr, "aws.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pluralSchemaEntry is an attribute or block of the generated data source's schema.
+type pluralSchemaEntry struct {
+	// Kind is one of "filter", "id" or "set".
+	Kind       string
+	TFName     string
+	TFNameExpr string
+	Field      string
+	ModelType  string
+}
+
+// SchemaEntries returns the attributes and blocks of the generated data source's schema, sorted by name.
+func (td *pluralTemplateData) SchemaEntries() []*pluralSchemaEntry {
+	entries := []*pluralSchemaEntry{{
+		Kind:       "id",
+		TFName:     "id",
+		TFNameExpr: "names.AttrID",
+		Field:      "ID",
+		ModelType:  "types.String",
+	}}
+
+	if td.FiltersFunc != "" {
+		entries = append(entries, &pluralSchemaEntry{
+			Kind:       "filter",
+			TFName:     "filter",
+			TFNameExpr: "names.AttrFilter",
+			Field:      "Filters",
+			ModelType:  "fwtypes.SetNestedObjectValueOf[namevaluesfiltersv2.FilterModel]",
+		})
+	}
+
+	for _, v := range td.Attributes {
+		entries = append(entries, &pluralSchemaEntry{
+			Kind:       "set",
+			TFName:     v.TFName,
+			TFNameExpr: v.TFNameExpr,
+			Field:      v.Field,
+			ModelType:  "fwtypes.SetValueOf[types.String]",
+		})
+	}
+
+	slices.SortFunc(entries, func(a, b *pluralSchemaEntry) int {
+		return strings.Compare(a.TFName, b.TFName)
+	})
+
+	return entries
+}
+
+// ExampleFilterName returns the filter name used in examples and tests.
+func (td *pluralTemplateData) ExampleFilterName() string {
+	if len(td.FilterNames) > 0 {
+		return td.FilterNames[0]
+	}
+
+	return "name"
+}
+
+// FilterNamesList returns the known filter names as a documentation list, e.g. "`a`, `b`".
+func (td *pluralTemplateData) FilterNamesList() string {
+	names := make([]string, len(td.FilterNames))
+	for i, v := range td.FilterNames {
+		names[i] = "`" + v + "`"
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// CreatePlural generates a data source that lists all resources of a kind, its acceptance tests and documentation
+// from the shapes of the list operation in the AWS SDK for Go v2 API model.
+func CreatePlural(dsName, snakeName string, force, pluginFramework bool, opts PluralOptions) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	td, err := newTemplateData(servicePackage, dsName, snakeName, false, false, true, pluginFramework)
+	if err != nil {
+		return err
+	}
+
+	if opts.SDKPackage == "" {
+		sr, err := data.LookupService(servicePackage)
+		if err != nil {
+			return fmt.Errorf("error looking up service (%s): %w", servicePackage, err)
+		}
+		opts.SDKPackage = sr.GoV2Package()
+	}
+
+	if opts.ListOperation == "" {
+		opts.ListOperation = "List" + dsName
+	}
+
+	service, err := apimodel.Load(opts.SDKPackage, wd)
+	if err != nil {
+		return err
+	}
+
+	ptd, err := newPluralTemplateData(td, service, opts)
+	if err != nil {
+		return err
+	}
+	ptd.EndpointIDExpr = apimodel.EndpointIDExpr(filepath.Join("..", "..", "..", "names", "names.go"), td.Service)
+
+	f := fmt.Sprintf("%s_data_source.go", td.DataSourceSnake)
+	if err = writeGoTemplate("newds", f, datasourcePluralTmpl, force, ptd); err != nil {
+		return fmt.Errorf("writing datasource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_data_source_test.go", td.DataSourceSnake)
+	if err = writeGoTemplate("dstest", tf, datasourcePluralTestTmpl, force, ptd); err != nil {
+		return fmt.Errorf("writing datasource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, td.DataSourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
+	if err = writeTemplate("webdoc", wf, websitePluralTmpl, force, ptd); err != nil {
+		return fmt.Errorf("writing datasource website doc template: %w", err)
+	}
+
+	if ptd.ListPagesFunc != "" {
+		directive := listPagesDirective(service, opts.ListOperation)
+		changed, ok, err := addListPagesDirective("generate.go", opts.ListOperation, directive)
+		if err != nil {
+			return fmt.Errorf("adding listpages directive: %w", err)
+		}
+
+		switch {
+		case !ok:
+			fmt.Printf("Add the following to generate.go and run go generate to generate %s:\n\n%s\n\n", ptd.ListPagesFunc, directive)
+		case changed:
+			fmt.Printf("Added %s to the listpages directives in generate.go. Run go generate to generate %s.\n", opts.ListOperation, ptd.ListPagesFunc)
+		}
+	}
+
+	if ptd.FiltersFunc != "" {
+		filename := filepath.Join("..", "..", "generate", "namevaluesfiltersv2", "service_filters_gen.go")
+		if b, err := os.ReadFile(filename); err != nil || !strings.Contains(string(b), ") "+ptd.FiltersFunc+"(") {
+			fmt.Printf("Add %q to sliceServiceNames in internal/generate/namevaluesfiltersv2/generators/servicefilters/main.go and run go generate to generate %s.\n", opts.SDKPackage, ptd.FiltersFunc)
+		}
+	}
+
+	return nil
+}
+
+func newPluralTemplateData(td TemplateData, service *apimodel.Service, opts PluralOptions) (*pluralTemplateData, error) {
+	operation := opts.ListOperation
+
+	if !service.HasOperation(operation) {
+		return nil, fmt.Errorf("operation (%s) not found in AWS SDK for Go v2 package (%s)", operation, opts.SDKPackage)
+	}
+
+	input, err := service.Input(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	// Plural data sources have no arguments other than filters, so can't set required input members.
+	var required []string
+	for _, m := range input.Members {
+		if m.Required {
+			required = append(required, m.Name)
+		}
+	}
+	if len(required) > 0 {
+		return nil, fmt.Errorf("operation (%s) has required input members (%s), which plural data sources do not support", operation, strings.Join(required, ", "))
+	}
+
+	output, err := service.Output(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	ptd := &pluralTemplateData{
+		TemplateData:         td,
+		DataSourceLowerCamel: convert.ToLowercasePrefix(td.DataSource),
+		SDKPackage:           opts.SDKPackage,
+		ListOperation:        operation,
+	}
+
+	var item *apimodel.Shape
+	for _, m := range output.Members {
+		if m.Type.Kind != apimodel.KindList || m.Type.Elem.Kind != apimodel.KindStructure {
+			continue
+		}
+
+		v, err := service.Structure(m.Type.Elem.Name)
+		if err != nil {
+			continue
+		}
+
+		ptd.ItemsField = m.Name
+		ptd.ItemType = "awstypes." + m.Type.Elem.Name
+		item = v
+		break
+	}
+
+	if item == nil {
+		return nil, fmt.Errorf("no list of structures found in %sOutput", operation)
+	}
+
+	ptd.Attributes = pluralAttributes(item, singular(td.DataSource))
+	if len(ptd.Attributes) == 0 {
+		return nil, fmt.Errorf("no ARN, ID or name members found in %s", strings.TrimPrefix(ptd.ItemType, "awstypes."))
+	}
+
+	switch {
+	case service.HasPaginator(operation) && !opts.ListPages:
+		ptd.Paginated = true
+	case paginatorFlags(input, output) != nil:
+		ptd.ListPagesFunc = strings.ToLower(operation[:1]) + operation[1:] + "Pages"
+	}
+
+	if m := input.Member("Filters"); m != nil && m.Type.Kind == apimodel.KindList && m.Type.Elem.Kind == apimodel.KindStructure {
+		ptd.FiltersFunc = strings.ToUpper(opts.SDKPackage[:1]) + opts.SDKPackage[1:] + "Filters"
+
+		if filter, err := service.Structure(m.Type.Elem.Name); err == nil {
+			for _, name := range []string{"Key", "Name"} {
+				if v := filter.Member(name); v != nil && v.Type.Kind == apimodel.KindEnum {
+					for _, v := range service.EnumValues(v.Type.Name) {
+						ptd.FilterNames = append(ptd.FilterNames, v.Value)
+					}
+				}
+			}
+		}
+	}
+
+	return ptd, nil
+}
+
+// pluralAttributes returns the computed attributes for the ARN, ID and name members of a listed item.
+func pluralAttributes(item *apimodel.Shape, singular string) []*pluralAttribute {
+	var attrs []*pluralAttribute
+
+	for _, v := range []struct {
+		suffixes    []string
+		tfName      string
+		tfNameExpr  string
+		field       string
+		description string
+	}{
+		{[]string{"Arn", "ARN"}, "arns", "names.AttrARNs", "ARNs", "ARNs"},
+		{[]string{"Id", "ID"}, "ids", "names.AttrIDs", "IDs", "identifiers"},
+		{[]string{"Name"}, "names", "names.AttrNames", "Names", "names"},
+	} {
+		if m := itemMember(item, singular, v.suffixes); m != nil {
+			valueExpr := "v." + m.Name
+			if strings.HasPrefix(m.Type.Expr, "*") {
+				valueExpr = fmt.Sprintf("aws.ToString(%s)", valueExpr)
+			}
+
+			attrs = append(attrs, &pluralAttribute{
+				TFName:      v.tfName,
+				TFNameExpr:  v.tfNameExpr,
+				Field:       v.field,
+				ValueExpr:   valueExpr,
+				Description: v.description,
+			})
+		}
+	}
+
+	return attrs
+}
+
+// itemMember returns the string member of a listed item named by one of the specified suffixes,
+// either exactly or prefixed by the singular resource name, e.g. "SecretArn".
+func itemMember(item *apimodel.Shape, singular string, suffixes []string) *apimodel.Member {
+	candidates := slices.Clone(suffixes)
+	for _, suffix := range suffixes {
+		candidates = append(candidates, singular+suffix)
+	}
+
+	for _, name := range candidates {
+		if m := item.Member(name); m != nil && m.Type.Kind == apimodel.KindString {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// singular returns the singular form of a plural name, e.g. "Secret" for "Secrets".
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "sses"), strings.HasSuffix(s, "shes"), strings.HasSuffix(s, "uses"), strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	default:
+		return strings.TrimSuffix(s, "s")
+	}
+}
+
+// paginatorFlags returns the internal/generate/listpages flags for the pagination token fields of an operation,
+// or nil if the operation is not paginated.
+func paginatorFlags(input, output *apimodel.Shape) []string {
+	for _, name := range []string{"NextToken", "Marker", "NextMarker", "PageToken", "NextPageToken"} {
+		if input.Member(name) != nil && output.Member(name) != nil {
+			if name == "NextToken" {
+				return []string{}
+			}
+
+			return []string{"-Paginator=" + name}
+		}
+	}
+
+	for _, in := range []string{"NextToken", "Marker", "PageToken"} {
+		if input.Member(in) == nil {
+			continue
+		}
+
+		for _, out := range []string{"NextToken", "NextMarker", "NextPageToken", "Marker"} {
+			if output.Member(out) != nil {
+				return []string{"-InputPaginator=" + in, "-OutputPaginator=" + out}
+			}
+		}
+	}
+
+	return nil
+}
+
+const listPagesDirectivePrefix = "//go:generate go run ../../generate/listpages/main.go"
+
+// listPagesDirective returns the go:generate directive that generates the listpages function for an operation.
+func listPagesDirective(service *apimodel.Service, operation string) string {
+	input, _ := service.Input(operation)
+	output, _ := service.Output(operation)
+
+	args := []string{"-AWSSDKVersion=2", "-ListOps=" + operation}
+	args = append(args, paginatorFlags(input, output)...)
+
+	return listPagesDirectivePrefix + " " + strings.Join(args, " ")
+}
+
+// addListPagesDirective adds the operation to the specified generate.go file.
+// The operation is appended to an existing listpages directive with the same flags or, if there is none, a new directive is added.
+// Returns whether the file was changed and whether it now contains a directive for the operation.
+func addListPagesDirective(filename, operation, directive string) (bool, bool, error) {
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, fmt.Errorf("reading %s: %w", filename, err)
+	}
+
+	lines := strings.Split(string(b), "\n")
+	_, want := splitListPagesDirective(directive)
+
+	first, defaultOutput := -1, false
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "//go:generate ") {
+			continue
+		}
+		if first == -1 {
+			first = i
+		}
+		if !strings.HasPrefix(line, listPagesDirectivePrefix+" ") {
+			continue
+		}
+
+		ops, args := splitListPagesDirective(line)
+		if args == nil {
+			// The directive writes to a named file.
+			continue
+		}
+		defaultOutput = true
+
+		if slices.Equal(args, want) {
+			if slices.Contains(ops, operation) {
+				return false, true, nil
+			}
+
+			lines[i] = strings.Replace(line, "-ListOps="+strings.Join(ops, ","), "-ListOps="+strings.Join(append(ops, operation), ","), 1)
+
+			return true, true, writeLines(filename, lines)
+		}
+	}
+
+	if first == -1 {
+		return false, false, nil
+	}
+
+	if defaultOutput {
+		// Don't overwrite the existing list_pages_gen.go.
+		directive += " -- " + convert.ToSnakeCase(operation, "") + "_pages_gen.go"
+	}
+
+	lines = slices.Insert(lines, first, directive)
+
+	return true, true, writeLines(filename, lines)
+}
+
+// splitListPagesDirective returns the operations and the other flags, sorted, of a listpages directive.
+// The flags are nil if the directive writes to a named file.
+func splitListPagesDirective(directive string) ([]string, []string) {
+	var ops []string
+	args := []string{}
+
+	for _, v := range strings.Fields(strings.TrimPrefix(directive, listPagesDirectivePrefix)) {
+		switch {
+		case v == "--":
+			return ops, nil
+		case strings.HasPrefix(v, "-ListOps="):
+			ops = strings.Split(strings.TrimPrefix(v, "-ListOps="), ",")
+		default:
+			args = append(args, v)
+		}
+	}
+
+	slices.Sort(args)
+
+	return ops, args
+}
+
+func writeLines(filename string, lines []string) error {
+	return os.WriteFile(filename, []byte(strings.Join(lines, "\n")), 0644)
+}
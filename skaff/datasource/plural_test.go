@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+func TestAddListPagesDirective(t *testing.T) {
+	t.Parallel()
+
+	const header = "// Copyright (c) HashiCorp, Inc.\n// SPDX-License-Identifier: MPL-2.0\n\n"
+	const footer = "//go:generate go run ../../generate/servicepackage/main.go\n// ONLY generate directives and package declaration! Do not add anything else to this file.\n\npackage widgets\n"
+
+	testCases := []struct {
+		name        string
+		contents    string
+		directive   string
+		wantChanged bool
+		wantOK      bool
+		want        string
+	}{
+		{
+			name:      "no directives",
+			contents:  header + "package widgets\n",
+			directive: "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets",
+			want:      header + "package widgets\n",
+		},
+		{
+			name:        "new directive",
+			contents:    header + footer,
+			directive:   "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets",
+			wantChanged: true,
+			wantOK:      true,
+			want:        header + "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets\n" + footer,
+		},
+		{
+			name:        "existing directive",
+			contents:    header + "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListGadgets\n" + footer,
+			directive:   "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets",
+			wantChanged: true,
+			wantOK:      true,
+			want:        header + "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListGadgets,ListWidgets\n" + footer,
+		},
+		{
+			name:      "existing operation",
+			contents:  header + "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets\n" + footer,
+			directive: "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets",
+			wantOK:    true,
+			want:      header + "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets\n" + footer,
+		},
+		{
+			name:        "different flags",
+			contents:    header + "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListGadgets\n" + footer,
+			directive:   "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets -Paginator=Marker",
+			wantChanged: true,
+			wantOK:      true,
+			want:        header + "//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListWidgets -Paginator=Marker -- list_widgets_pages_gen.go\n//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListGadgets\n" + footer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "generate.go")
+			if err := os.WriteFile(filename, []byte(testCase.contents), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			changed, ok, err := addListPagesDirective(filename, "ListWidgets", testCase.directive)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if changed != testCase.wantChanged {
+				t.Errorf("changed = %t, want %t", changed, testCase.wantChanged)
+			}
+			if ok != testCase.wantOK {
+				t.Errorf("ok = %t, want %t", ok, testCase.wantOK)
+			}
+
+			got, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != testCase.want {
+				t.Errorf("got\n%s\nwant\n%s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestNewPluralTemplateData(t *testing.T) {
+	t.Parallel()
+
+	service, err := apimodel.LoadDir("widgets", filepath.Join("..", "apimodel", "testdata", "widgets"))
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	testCases := []struct {
+		name          string
+		operation     string
+		wantErr       string
+		wantItemsType string
+	}{
+		{
+			name:          "list",
+			operation:     "ListWidgets",
+			wantItemsType: "awstypes.WidgetSummary",
+		},
+		{
+			name:      "required input member",
+			operation: "ListWidgetVersions",
+			wantErr:   "required input members (WidgetId)",
+		},
+		{
+			name:      "unknown operation",
+			operation: "ListGadgets",
+			wantErr:   "not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := TemplateData{
+				DataSource:     "Widgets",
+				ServicePackage: "widgets",
+				Service:        "Widgets",
+			}
+
+			ptd, err := newPluralTemplateData(td, service, PluralOptions{SDKPackage: "widgets", ListOperation: testCase.operation})
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("err = %v, want error containing %q", err, testCase.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := ptd.ItemType, testCase.wantItemsType; got != want {
+				t.Errorf("ItemType = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSingular(t *testing.T) {
+	t.Parallel()
+
+	for plural, want := range map[string]string{
+		"Secrets":       "Secret",
+		"EventBuses":    "EventBus",
+		"Policies":      "Policy",
+		"Addresses":     "Address",
+		"Boxes":         "Box",
+		"Repositories":  "Repository",
+		"ClusterHashes": "ClusterHash",
+	} {
+		if got := singular(plural); got != want {
+			t.Errorf("singular(%q) = %q, want %q", plural, got, want)
+		}
+	}
+}
@@ -0,0 +1,716 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed resourceapimodel.tmpl
+var resourceAPIModelTmpl string
+
+//go:embed resourceapimodeltest.tmpl
+var resourceAPIModelTestTmpl string
+
+//go:embed websitedocapimodel.tmpl
+var websiteAPIModelTmpl string
+
+//go:embed sweepapimodel.tmpl
+var sweepAPIModelTmpl string
+
+// APIModelOptions are the AWS API operations from which a resource is generated.
+type APIModelOptions struct {
+	// SDKPackage is the name of the AWS SDK for Go v2 service package.
+	// Defaults to the service's package in names/data.
+	SDKPackage string
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string // Optional.
+	DeleteOperation string
+	ListOperation   string // Optional. Defaults to List<Resource>s, if present.
+}
+
+type apiModelTemplateData struct {
+	TemplateData
+
+	ResourceLowerCamel string
+	// EndpointIDExpr is the Go expression for the service's endpoint ID, e.g. names.EventsEndpointID.
+	EndpointIDExpr string
+	SDKPackage     string
+	Options        APIModelOptions
+
+	// Attributes and Blocks are the top-level schema of the resource.
+	Attributes []*apimodel.Attribute
+	Blocks     []*apimodel.Attribute
+	// Models are the AutoFlex models of nested objects.
+	Models []*apimodel.Model
+	Unions []*apimodel.Union
+	// Imports are the import specifications of the generated resource, standard library packages first.
+	Imports [][]string
+
+	// IDMember is the read operation's input member identifying the resource.
+	IDMember string
+	// IDField is the model field set from the resource's ID, or "" if the resource's ID is the API's Id member.
+	IDField string
+	idAttr  *apimodel.Attribute
+	// CreateResultField and ReadResultField are the selectors, e.g. ".Widget", of the resource in the operations' outputs.
+	CreateResultField string
+	ReadResultField   string
+	// ReadResultType is the Go type returned by the finder.
+	ReadResultType string
+	NotFoundError  string
+
+	Status *apiModelStatus
+	List   *apiModelList
+
+	// ExampleAttributes are the required top-level attributes, for the acceptance test and documentation examples.
+	ExampleAttributes []*apimodel.Attribute
+	// CreateInputTags indicates whether the create operation's input has a Tags member.
+	CreateInputTags bool
+	// Skipped lists the API members that are not part of the generated schema.
+	Skipped []string
+}
+
+type apiModelStatus struct {
+	Field string
+	// PendingExpr, TargetExpr and DeletePendingExpr are Go expressions for the waiters' states.
+	PendingExpr       string
+	TargetExpr        string
+	DeletePendingExpr string
+}
+
+type apiModelList struct {
+	Operation  string
+	ItemsField string
+	ItemID     string
+	Paginated  bool
+}
+
+// CreateFromAPIModel generates a Terraform Plugin Framework resource, its acceptance tests, sweeper and documentation
+// from the shapes of the specified operations in the AWS SDK for Go v2 API model.
+func CreateFromAPIModel(resName, snakeName string, force, tags bool, opts APIModelOptions) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	td, err := newTemplateData(servicePackage, resName, snakeName, false, tags, true, true)
+	if err != nil {
+		return err
+	}
+
+	if opts.CreateOperation == "" || opts.ReadOperation == "" || opts.DeleteOperation == "" {
+		return fmt.Errorf("error checking: create, read and delete operations are required")
+	}
+
+	if opts.SDKPackage == "" {
+		sr, err := data.LookupService(servicePackage)
+		if err != nil {
+			return fmt.Errorf("error looking up service (%s): %w", servicePackage, err)
+		}
+		opts.SDKPackage = sr.GoV2Package()
+	}
+
+	service, err := apimodel.Load(opts.SDKPackage, wd)
+	if err != nil {
+		return err
+	}
+
+	amtd, err := newAPIModelTemplateData(td, service, opts)
+	if err != nil {
+		return err
+	}
+	amtd.setTFNameExprs(readAttrConsts(filepath.Join("..", "..", "..", "names", "attr_consts_gen.go")))
+	amtd.EndpointIDExpr = apimodel.EndpointIDExpr(filepath.Join("..", "..", "..", "names", "names.go"), td.Service)
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err = writeGoTemplate("newres", f, resourceAPIModelTmpl, force, amtd); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceAPIModelTestTmpl, force, amtd); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, td.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteAPIModelTmpl, force, amtd); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	fmt.Printf("Add the following to exports_test.go:\n\n\tResource%[1]s = new%[1]sResource\n\tFind%[1]sByID = find%[1]sByID\n\n", resName)
+
+	if amtd.List == nil {
+		fmt.Println("No list operation found, so no sweeper was generated.")
+	} else if err = writeSweeper(amtd); err != nil {
+		return fmt.Errorf("writing sweeper: %w", err)
+	}
+
+	for _, v := range amtd.Skipped {
+		fmt.Printf("Skipped API member: %s\n", v)
+	}
+
+	return nil
+}
+
+func newAPIModelTemplateData(td TemplateData, service *apimodel.Service, opts APIModelOptions) (*apiModelTemplateData, error) {
+	amtd := &apiModelTemplateData{
+		TemplateData:       td,
+		ResourceLowerCamel: convert.ToLowercasePrefix(td.Resource),
+		SDKPackage:         service.Package,
+		Options:            opts,
+		NotFoundError:      service.NotFoundError(),
+	}
+
+	createInput, err := service.Input(opts.CreateOperation)
+	if err != nil {
+		return nil, err
+	}
+	createOutput, err := service.Output(opts.CreateOperation)
+	if err != nil {
+		return nil, err
+	}
+	readInput, err := service.Input(opts.ReadOperation)
+	if err != nil {
+		return nil, err
+	}
+	readOutput, err := service.Output(opts.ReadOperation)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := service.Input(opts.DeleteOperation); err != nil {
+		return nil, err
+	}
+	var updateInput *apimodel.Shape
+	if opts.UpdateOperation != "" {
+		if updateInput, err = service.Input(opts.UpdateOperation); err != nil {
+			return nil, err
+		}
+	}
+
+	// The resource is identified by the read operation's first required input member.
+	for _, m := range readInput.Members {
+		if m.Required {
+			amtd.IDMember = m.Name
+			break
+		}
+	}
+	if amtd.IDMember == "" {
+		return nil, fmt.Errorf("read operation (%s) has no required input member to identify the resource", opts.ReadOperation)
+	}
+	if readInput.Member(amtd.IDMember).Type.Kind != apimodel.KindString {
+		return nil, fmt.Errorf("read operation (%s) input member (%s) is not a string", opts.ReadOperation, amtd.IDMember)
+	}
+
+	// The resource is the read operation's output structure named for the resource, or its only output structure.
+	result := readOutput
+	amtd.ReadResultType = fmt.Sprintf("%s.%s", service.Package, readOutput.Name)
+	if m := resultMember(readOutput, td.Resource); m != nil {
+		if result, err = service.Structure(m.Type.Name); err != nil {
+			return nil, err
+		}
+		amtd.ReadResultField = "." + m.Name
+		amtd.ReadResultType = "awstypes." + m.Type.Name
+
+		for _, v := range createOutput.Members {
+			if v.Type.Kind == apimodel.KindStructure && v.Type.Name == m.Type.Name {
+				amtd.CreateResultField = "." + v.Name
+				break
+			}
+		}
+	}
+
+	b := apimodel.NewBuilder(service)
+	attrs := make(map[string]*apimodel.Attribute)
+
+	for _, m := range createInput.Members {
+		switch {
+		case m.Name == "Tags":
+			// Tags are managed by transparent tagging, not as an attribute.
+			if td.IncludeTags {
+				amtd.CreateInputTags = true
+			} else {
+				b.Skipped = append(b.Skipped, fmt.Sprintf("%s (tags are not included)", m.Name))
+			}
+			continue
+		case m.Name == "ClientToken" || m.Name == "ClientRequestToken":
+			// Idempotency tokens are set by the AWS SDK.
+			continue
+		}
+
+		attr, ok := b.Attribute(m)
+		if !ok {
+			continue
+		}
+
+		if m.Required {
+			attr.Required = true
+		} else {
+			attr.Optional = true
+		}
+		if updateInput == nil || updateInput.Member(m.Name) == nil || m.Name == amtd.IDMember {
+			attr.RequiresReplace = true
+		}
+
+		attrs[m.Name] = attr
+	}
+
+	for _, m := range result.Members {
+		if m.Name == "Tags" {
+			continue
+		}
+
+		if attr, ok := attrs[m.Name]; ok {
+			if attr.Optional && !attr.IsBlock() {
+				attr.Computed = true
+			}
+			continue
+		}
+
+		// Blocks cannot be Computed.
+		if m.Type.IsNested() {
+			b.Skipped = append(b.Skipped, fmt.Sprintf("%s (computed nested object)", m.Name))
+			continue
+		}
+
+		attr, ok := b.Attribute(m)
+		if !ok {
+			continue
+		}
+
+		attr.Computed = true
+		if attr.TFName == "arn" {
+			attr.SchemaExpr = "framework.ARNAttributeComputedOnly()"
+		}
+
+		attrs[m.Name] = attr
+	}
+
+	if amtd.IDMember != "Id" {
+		attr, ok := attrs[amtd.IDMember]
+		if !ok {
+			return nil, fmt.Errorf("resource identifier (%s) is neither a create operation input member nor a read operation output member", amtd.IDMember)
+		}
+		amtd.IDField = attr.Name
+		amtd.idAttr = attr
+		// The resource's identifier is needed to update it, so must be known from the plan.
+		if attr.Computed {
+			attr.UseStateForUnknown = true
+		}
+	}
+	for k, attr := range attrs {
+		if attr.TFName == "id" {
+			if k != amtd.IDMember {
+				b.Skipped = append(b.Skipped, fmt.Sprintf("%s (conflicts with id)", k))
+			}
+			delete(attrs, k)
+		}
+	}
+
+	attrs["ID"] = &apimodel.Attribute{
+		Name:       "ID",
+		TFName:     "id",
+		TFNameExpr: strconv.Quote("id"),
+		ModelType:  "types.String",
+		SchemaExpr: "framework.IDAttribute()",
+		Computed:   true,
+	}
+	if td.IncludeTags {
+		attrs["Tags"] = &apimodel.Attribute{
+			Name:       "Tags",
+			TFName:     "tags",
+			TFNameExpr: strconv.Quote("tags"),
+			ModelType:  "types.Map",
+			SchemaExpr: "tftags.TagsAttribute()",
+		}
+		attrs["TagsAll"] = &apimodel.Attribute{
+			Name:       "TagsAll",
+			TFName:     "tags_all",
+			TFNameExpr: strconv.Quote("tags_all"),
+			ModelType:  "types.Map",
+			SchemaExpr: "tftags.TagsAttributeComputedOnly()",
+			Computed:   true,
+		}
+	}
+
+	for _, attr := range attrs {
+		if attr.IsBlock() {
+			amtd.Blocks = append(amtd.Blocks, attr)
+		} else {
+			amtd.Attributes = append(amtd.Attributes, attr)
+		}
+		if attr.Required {
+			amtd.ExampleAttributes = append(amtd.ExampleAttributes, attr)
+		}
+	}
+	sortByTFName(amtd.Attributes)
+	sortByTFName(amtd.Blocks)
+	sortByTFName(amtd.ExampleAttributes)
+
+	amtd.Models = b.Models
+	amtd.Unions = b.Unions
+	amtd.Skipped = b.Skipped
+	amtd.Status = newAPIModelStatus(service, result, td.Resource)
+	amtd.List = newAPIModelList(service, opts, td.Resource, amtd.IDMember)
+	amtd.Imports = apiModelImports(amtd, b)
+
+	return amtd, nil
+}
+
+// IDTFNameExpr returns the Go expression for the Terraform attribute name of IDField.
+func (td *apiModelTemplateData) IDTFNameExpr() string {
+	return td.idAttr.TFNameExpr
+}
+
+// IDTFName returns the Terraform attribute name of IDField.
+func (td *apiModelTemplateData) IDTFName() string {
+	return td.idAttr.TFName
+}
+
+// HasExampleName returns whether the resource has a required name attribute.
+func (td *apiModelTemplateData) HasExampleName() bool {
+	for _, attr := range td.ExampleAttributes {
+		if attr.TFName == "name" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Fields returns the fields of the resource model.
+func (td *apiModelTemplateData) Fields() []*apimodel.Attribute {
+	model := apimodel.Model{
+		Attributes: td.Attributes,
+		Blocks:     td.Blocks,
+	}
+
+	return model.Fields()
+}
+
+// setTFNameExprs uses the attribute name constants in the names package for the Terraform attribute names, if possible.
+func (td *apiModelTemplateData) setTFNameExprs(consts map[string]string) {
+	attrs := append(td.Attributes, td.Blocks...)
+	for _, model := range td.Models {
+		attrs = append(attrs, model.Attributes...)
+		attrs = append(attrs, model.Blocks...)
+	}
+
+	for _, attr := range attrs {
+		if v, ok := consts[attr.TFName]; ok {
+			attr.TFNameExpr = "names." + v
+		}
+	}
+}
+
+// resultMember returns the structure member of an operation's output that describes the resource.
+func resultMember(output *apimodel.Shape, resName string) *apimodel.Member {
+	var structures []*apimodel.Member
+
+	for _, m := range output.Members {
+		if m.Type.Kind != apimodel.KindStructure {
+			continue
+		}
+		if m.Name == resName || m.Type.Name == resName {
+			return m
+		}
+		structures = append(structures, m)
+	}
+
+	if len(structures) == 1 {
+		return structures[0]
+	}
+
+	return nil
+}
+
+var (
+	statusPendingRegexp  = regexache.MustCompile(`(?i)(creat|pending|provisioning|progress|updat|deploying|starting)`)
+	statusTargetRegexp   = regexache.MustCompile(`(?i)^(active|available|ready|created|enabled|deployed|succeeded|completed?|in_?service|running)$`)
+	statusDeletingRegexp = regexache.MustCompile(`(?i)delet`)
+)
+
+// newAPIModelStatus returns the resource's status, used by waiters, or nil if the resource has no status.
+func newAPIModelStatus(service *apimodel.Service, result *apimodel.Shape, resName string) *apiModelStatus {
+	for _, name := range []string{"Status", "State", resName + "Status", resName + "State"} {
+		m := result.Member(name)
+		if m == nil || m.Type.Kind != apimodel.KindEnum {
+			continue
+		}
+
+		var pending, target, deleting []string
+		for _, v := range service.EnumValues(m.Type.Name) {
+			switch {
+			case statusDeletingRegexp.MatchString(v.Value):
+				deleting = append(deleting, v.Name)
+			case statusTargetRegexp.MatchString(v.Value):
+				target = append(target, v.Name)
+			case statusPendingRegexp.MatchString(v.Value):
+				pending = append(pending, v.Name)
+			}
+		}
+
+		if len(target) == 0 {
+			return nil
+		}
+
+		return &apiModelStatus{
+			Field:             m.Name,
+			PendingExpr:       enumSliceExpr(pending),
+			TargetExpr:        enumSliceExpr(target),
+			DeletePendingExpr: enumSliceExpr(append(deleting, target...)),
+		}
+	}
+
+	return nil
+}
+
+// enumSliceExpr returns a Go expression for the string values of the specified enum constants.
+func enumSliceExpr(consts []string) string {
+	if len(consts) == 0 {
+		return "[]string{}"
+	}
+
+	v := make([]string, len(consts))
+	for i, c := range consts {
+		v[i] = "awstypes." + c
+	}
+
+	return fmt.Sprintf("enum.Slice(%s)", strings.Join(v, ", "))
+}
+
+// newAPIModelList returns the list operation used by the sweeper, or nil if there is no suitable operation.
+func newAPIModelList(service *apimodel.Service, opts APIModelOptions, resName, idMember string) *apiModelList {
+	operation := opts.ListOperation
+	if operation == "" {
+		operation = "List" + resName + "s"
+	}
+
+	output, err := service.Output(operation)
+	if err != nil {
+		return nil
+	}
+
+	for _, m := range output.Members {
+		if m.Type.Kind != apimodel.KindList || m.Type.Elem.Kind != apimodel.KindStructure {
+			continue
+		}
+
+		item, err := service.Structure(m.Type.Elem.Name)
+		if err != nil {
+			continue
+		}
+
+		for _, name := range []string{idMember, "Id"} {
+			if v := item.Member(name); v != nil && v.Type.Kind == apimodel.KindString {
+				return &apiModelList{
+					Operation:  operation,
+					ItemsField: m.Name,
+					ItemID:     v.Name,
+					Paginated:  service.HasPaginator(operation),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func apiModelImports(td *apiModelTemplateData, b *apimodel.Builder) [][]string {
+	sdkPackage := "github.com/aws/aws-sdk-go-v2/service/" + td.SDKPackage
+
+	imports := []string{
+		`"context"`,
+		`"fmt"`,
+		`"github.com/aws/aws-sdk-go-v2/aws"`,
+		strconv.Quote(sdkPackage),
+		`"github.com/hashicorp/terraform-plugin-framework/resource"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource/schema"`,
+		`"github.com/hashicorp/terraform-plugin-framework/types"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+		`fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/tfresource"`,
+		`"github.com/hashicorp/terraform-provider-aws/names"`,
+	}
+	imports = append(imports, b.Imports()...)
+
+	if td.NotFoundError != "" || td.Status != nil || strings.HasPrefix(td.ReadResultType, "awstypes.") {
+		imports = append(imports, fmt.Sprintf("awstypes %q", sdkPackage+"/types"))
+	}
+	if td.NotFoundError != "" {
+		imports = append(imports, `"github.com/hashicorp/terraform-provider-aws/internal/errs"`)
+	}
+	if td.NotFoundError != "" || td.Status != nil {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"`)
+	}
+	if td.Status != nil {
+		imports = append(imports,
+			`"time"`,
+			`"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"`,
+			`"github.com/hashicorp/terraform-provider-aws/internal/enum"`,
+		)
+	}
+	if td.IncludeTags {
+		imports = append(imports, `tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`)
+	}
+
+	var planModifiers bool
+	for _, attr := range append(td.Attributes, td.Blocks...) {
+		if attr.SchemaExpr == "" && (attr.RequiresReplace || attr.UseStateForUnknown) {
+			planModifiers = true
+			imports = append(imports, fmt.Sprintf(`"github.com/hashicorp/terraform-plugin-framework/resource/schema/%s"`, attr.PlanModifierPackage()))
+		}
+		if attr.IsBlock() && (attr.Required || attr.MaxItems1) {
+			imports = append(imports,
+				`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`,
+				`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`,
+			)
+		}
+	}
+	for _, model := range td.Models {
+		for _, attr := range model.Blocks {
+			if attr.Required || attr.MaxItems1 {
+				imports = append(imports,
+					`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`,
+					`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`,
+				)
+			}
+		}
+	}
+	if planModifiers {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`)
+	}
+
+	var std, other []string
+	for _, v := range uniqueSorted(imports) {
+		path := v[strings.Index(v, `"`):]
+		if strings.Contains(path, ".") {
+			other = append(other, v)
+		} else {
+			std = append(std, v)
+		}
+	}
+
+	return [][]string{std, other}
+}
+
+func sortByTFName(attrs []*apimodel.Attribute) {
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].TFName < attrs[j].TFName
+	})
+}
+
+func uniqueSorted(s []string) []string {
+	m := make(map[string]bool, len(s))
+	var v []string
+
+	for _, e := range s {
+		if !m[e] {
+			m[e] = true
+			v = append(v, e)
+		}
+	}
+
+	sort.Strings(v)
+
+	return v
+}
+
+// readAttrConsts returns the attribute name constants declared in the specified file, keyed by attribute name.
+// Returns nil if the file cannot be read.
+func readAttrConsts(filename string) map[string]string {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return nil
+	}
+
+	consts := make(map[string]string)
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			spec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+				continue
+			}
+			lit, ok := spec.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+			if v, err := strconv.Unquote(lit.Value); err == nil {
+				consts[v] = spec.Names[0].Name
+			}
+		}
+	}
+
+	return consts
+}
+
+// writeSweeper adds the resource's sweeper to sweep.go, creating the file if it does not exist.
+func writeSweeper(td *apiModelTemplateData) error {
+	const filename = "sweep.go"
+
+	_, err := os.Stat(filename)
+	create := errors.Is(err, fs.ErrNotExist)
+
+	sweepTD := struct {
+		*apiModelTemplateData
+		NewFile bool
+	}{
+		apiModelTemplateData: td,
+		NewFile:              create,
+	}
+
+	if create {
+		return writeGoTemplate("sweep", filename, sweepAPIModelTmpl, false, sweepTD)
+	}
+
+	contents, err := executeTemplate("sweep", sweepAPIModelTmpl, sweepTD)
+	if err != nil {
+		return err
+	}
+
+	existing, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	contents = append(existing, contents...)
+	if v, err := format.Source(contents); err == nil {
+		contents = v
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	fmt.Printf("Register the sweeper in RegisterSweepers in %[1]s:\n\n\tsweep.Register(\"aws_%[2]s_%[3]s\", sweep%[4]ss)\n\n", filename, td.ServicePackage, td.ResourceSnake, td.Resource)
+
+	return nil
+}
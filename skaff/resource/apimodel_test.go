@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"flag"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of generated resources")
+
+func TestResourceAPIModelTemplate(t *testing.T) {
+	t.Parallel()
+
+	service, err := apimodel.LoadDir("widgets", filepath.Join("..", "apimodel", "testdata", "widgets"))
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	opts := APIModelOptions{
+		SDKPackage:      "widgets",
+		CreateOperation: "CreateWidget",
+		ReadOperation:   "GetWidget",
+		UpdateOperation: "UpdateWidget",
+		DeleteOperation: "DeleteWidget",
+	}
+	consts := map[string]string{
+		"arn":        "AttrARN",
+		"created_at": "AttrCreatedAt",
+		"id":         "AttrID",
+		"name":       "AttrName",
+		"status":     "AttrStatus",
+		"tags":       "AttrTags",
+		"tags_all":   "AttrTagsAll",
+	}
+
+	testCases := map[string]struct {
+		tags    bool
+		skipped []string
+	}{
+		"widget": {
+			skipped: []string{"Configuration (Configuration)", "Data ([]byte)", "Tags (tags are not included)"},
+		},
+		"widget_tags": {
+			tags:    true,
+			skipped: []string{"Configuration (Configuration)", "Data ([]byte)"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			td := TemplateData{
+				Resource:             "Widget",
+				ResourceLower:        "widget",
+				ResourceSnake:        "widget",
+				HumanFriendlyService: "Widgets",
+				IncludeTags:          testCase.tags,
+				ServicePackage:       "widgets",
+				Service:              "Widgets",
+				ServiceLower:         "widgets",
+				AWSServiceName:       "Amazon Widgets",
+				AWSGoSDKV2:           true,
+				PluginFramework:      true,
+				HumanResourceName:    "Widget",
+				ProviderResourceName: "aws_widgets_widget",
+			}
+
+			amtd, err := newAPIModelTemplateData(td, service, opts)
+			if err != nil {
+				t.Fatalf("building template data: %s", err)
+			}
+			amtd.setTFNameExprs(consts)
+			amtd.EndpointIDExpr = "names.WidgetsEndpointID"
+
+			if got, want := amtd.Skipped, testCase.skipped; !slices.Equal(got, want) {
+				t.Errorf("Skipped = %v, want %v", got, want)
+			}
+
+			contents, err := executeTemplate("newres", resourceAPIModelTmpl, amtd)
+			if err != nil {
+				t.Fatalf("executing template: %s", err)
+			}
+			got, err := format.Source(contents)
+			if err != nil {
+				t.Fatalf("formatting: %s\n%s", err, contents)
+			}
+
+			golden := filepath.Join("testdata", name+".go.golden")
+			if *updateGolden {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("reading golden file: %s", err)
+			}
+
+			if string(got) != string(want) {
+				t.Errorf("generated resource differs from %s, run with -update to update it:\n%s", golden, got)
+			}
+		})
+	}
+}